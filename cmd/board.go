@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/term"
 	"youtrack-cli/internal/youtrack"
 
 	"github.com/spf13/cobra"
@@ -35,7 +36,111 @@ var boardListCmd = &cobra.Command{
 	},
 }
 
+var boardShowCmd = &cobra.Command{
+	Use:   "show [name]",
+	Short: "Show a board as kanban columns",
+	Long: `Renders the columns and swimlanes of an agile board with the issues of its current sprint,
+or all its issues when the board has sprints disabled. Issue based swimlanes group cards under
+their parent issue.
+The layout adapts to the terminal width and column headers flag WIP limit violations.
+Uses the default board from config if no name is given.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		boardName, err := boardFromArg(cfg, args)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		board, err := youtrack.FindBoard(cfg, boardName)
+		if err != nil {
+			fmt.Printf("Error finding board '%s': %v\n", boardName, err)
+			return
+		}
+
+		board, err = youtrack.FetchBoardDetails(cfg, board)
+		if err != nil {
+			fmt.Printf("Error fetching board '%s': %v\n", boardName, err)
+			return
+		}
+
+		sprintRef, _ := cmd.Flags().GetString("sprint")
+		if s := board.SprintsSettings; s != nil && s.DisableSprints && sprintRef != "" {
+			fmt.Printf("Error: board '%s' has sprints disabled, --sprint cannot be used\n", board.Name)
+			return
+		}
+		if sprintRef == "" {
+			sprintRef = youtrack.SprintCurrent
+		}
+		sprint, err := youtrack.BoardSprint(cfg, board, sprintRef)
+		if err != nil {
+			fmt.Printf("Error resolving sprint: %v\n", err)
+			return
+		}
+
+		issues, err := youtrack.FetchSprintIssues(cfg, board, sprint)
+		if err != nil {
+			fmt.Printf("Error fetching issues for sprint '%s': %v\n", sprint.Name, err)
+			return
+		}
+
+		width, _ := cmd.Flags().GetInt("width")
+		if width <= 0 {
+			width = term.Width()
+		}
+		youtrack.PrintKanban(youtrack.BuildKanban(board, sprint, issues), width)
+	},
+}
+
+var boardInspectCmd = &cobra.Command{
+	Use:   "inspect [name]",
+	Short: "Show how a board is configured",
+	Long: `Reports which custom field defines the columns and which values map to each column,
+the swimlane and sprint settings, the estimation fields and the projects shown on a board.
+Uses the default board from config if no name is given.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		boardName, err := boardFromArg(cfg, args)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		board, err := youtrack.FindBoard(cfg, boardName)
+		if err != nil {
+			fmt.Printf("Error finding board '%s': %v\n", boardName, err)
+			return
+		}
+
+		board, err = youtrack.FetchBoardDetails(cfg, board)
+		if err != nil {
+			fmt.Printf("Error fetching board '%s': %v\n", boardName, err)
+			return
+		}
+
+		youtrack.PrintBoardInspection(board)
+	},
+}
+
 func init() {
 	// rootCmd.AddCommand(boardCmd) // REMOVED: Added in cmd/root.go
 	boardCmd.AddCommand(boardListCmd)
+
+	boardCmd.AddCommand(boardShowCmd)
+	boardShowCmd.Flags().StringP("sprint", "s", "", "Sprint to show (a name, or 'current', 'next', 'previous'; default: current)")
+	boardShowCmd.Flags().IntP("width", "w", 0, "Render width in columns (default: terminal width)")
+
+	boardCmd.AddCommand(boardInspectCmd)
 }
\ No newline at end of file
@@ -1,5 +1,13 @@
 package cmd
 
+import (
+	"fmt"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
 // This file can be used for shared flags, common utility functions
 // that are specific to the command-line interface (Cobra commands).
 // For example:
@@ -7,3 +15,45 @@ package cmd
 // func init() {
 //     rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "verbose output")
 // }
+
+// boardFromFlag returns the --board flag value, falling back to the configured default board.
+func boardFromFlag(cmd *cobra.Command, cfg config.Config) (string, error) {
+	boardName, _ := cmd.Flags().GetString("board")
+	if boardName == "" {
+		boardName = cfg.BoardName
+	}
+	if boardName == "" {
+		return "", fmt.Errorf("board name not specified. Please use the --board flag or set a default board using 'youtrack-cli config set board [board_name]'")
+	}
+	return boardName, nil
+}
+
+// boardFromArg returns the optional [name] argument of board commands, falling back to the configured default board.
+func boardFromArg(cfg config.Config, args []string) (string, error) {
+	boardName := cfg.BoardName
+	if len(args) > 0 {
+		boardName = args[0]
+	}
+	if boardName == "" {
+		return "", fmt.Errorf("board name not specified. Please pass it as an argument or set a default board using 'youtrack-cli config set board [board_name]'")
+	}
+	return boardName, nil
+}
+
+// resolveSprintArg resolves the optional [name] argument of sprint commands.
+// Without an argument the configured default sprint is used, then the board's current sprint.
+func resolveSprintArg(cmd *cobra.Command, cfg config.Config, args []string) (youtrack.AgileBoard, youtrack.Sprint, error) {
+	boardName, err := boardFromFlag(cmd, cfg)
+	if err != nil {
+		return youtrack.AgileBoard{}, youtrack.Sprint{}, err
+	}
+
+	ref := youtrack.SprintCurrent
+	if len(args) > 0 {
+		ref = args[0]
+	} else if cfg.DefaultSprint != "" {
+		ref = cfg.DefaultSprint
+	}
+
+	return youtrack.ResolveSprint(cfg, boardName, ref)
+}
@@ -23,8 +23,9 @@ var listCmd = &cobra.Command{
 		assigneeName, _ := cmd.Flags().GetString("assignee")
 		issueType, _ := cmd.Flags().GetString("type") // 新增：讀取 --type 旗標
 
-		// Determine sprint name (flag > default config > latest sprint)
-		determinedSprint, err := youtrack.DetermineSprint(cfg, sprintName)
+		// Determine sprint name (flag > default config > board's current sprint)
+		// Board names in the query must match exactly, so the board comes back resolved too
+		determinedSprint, boardName, err := youtrack.DetermineSprint(cfg, sprintName)
 		if err != nil {
 			fmt.Printf("Warning: Could not determine sprint: %v. Listing issues without sprint filter.\n", err)
 			determinedSprint = "" // Proceed without sprint filter if determination fails
@@ -32,14 +33,20 @@ var listCmd = &cobra.Command{
 
 		// Build YouTrack query string
 		// 新增：傳遞 issueType 參數
-		query := youtrack.BuildQuery(determinedSprint, assigneeName, issueType, cfg.BoardName)
+		query, err := youtrack.BuildQuery(determinedSprint, assigneeName, issueType, boardName)
+		if err != nil {
+			fmt.Printf("Warning: %v. Listing issues without sprint filter.\n", err)
+		}
 
 		// Fetch issues from YouTrack API
-		issues, err := youtrack.FetchIssues(cfg, query)
+		issues, warnings, err := youtrack.FetchIssues(cfg, query)
 		if err != nil {
 			fmt.Printf("Error fetching issues: %v\n", err)
 			return
 		}
+		for _, w := range warnings {
+			fmt.Printf("Warning: %s\n", w)
+		}
 
 		// Print issues in a formatted table
 		youtrack.PrintIssues(issues)
@@ -54,7 +61,7 @@ func init() {
 	// rootCmd.AddCommand(listCmd) // REMOVED: Added in cmd/root.go
 
 	// Define flags for the list command
-	listCmd.Flags().StringP("sprint", "s", "", "Specify the sprint to list issues from")
+	listCmd.Flags().StringP("sprint", "s", "", "Specify the sprint to list issues from (a name, or 'current', 'next', 'previous')")
 	listCmd.Flags().StringP("assignee", "a", "", "Specify the assignee to list issues for (e.g., 'me', 'unassigned', or a username)")
 	listCmd.Flags().StringP("type", "t", "", "Filter issues by Type (e.g., 'Task', 'Bug', 'Story')") // 新增：--type 旗標
 }
\ No newline at end of file
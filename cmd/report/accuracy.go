@@ -0,0 +1,41 @@
+package report
+
+import (
+	"fmt"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
+var accuracyCmd = &cobra.Command{
+	Use:   "accuracy",
+	Short: "Compare estimations with time spent",
+	Long: `Compares the Estimation of each resolved issue with its Spent time, selected by --sprint or
+--query. Reports the distribution of spent/estimation ratios, the worst offenders and the bias
+of each assignee; a ratio above 1 means the work was under-estimated.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		issues, err := issuesFromFlags(cmd, cfg, "")
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		worst, _ := cmd.Flags().GetInt("worst")
+		youtrack.PrintAccuracy(youtrack.BuildAccuracy(issues), worst)
+	},
+}
+
+func init() {
+	ReportCmd.AddCommand(accuracyCmd) // ReportCmd is defined in cmd/report/root.go
+
+	addIssueFlags(accuracyCmd)
+	accuracyCmd.Flags().IntP("worst", "n", 5, "Number of worst offenders to list")
+}
@@ -0,0 +1,50 @@
+package report
+
+import (
+	"fmt"
+	"time"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
+var agingCmd = &cobra.Command{
+	Use:   "aging",
+	Short: "List unresolved issues by time in their current state",
+	Long: `Lists the unresolved issues of the current sprint on the configured board (or of --sprint,
+--board or --query) sorted by how long they have been in their current state. The time is taken
+from the last State change in the activity history, so comments do not reset it. Issues past the
+aging thresholds from the config (warn_days, stale_days, optionally per state) are flagged.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+		if err := cfg.Aging.Validate(); err != nil {
+			fmt.Printf("Error in configuration: %v\n", err)
+			return
+		}
+
+		issues, err := issuesFromFlags(cmd, cfg, youtrack.SprintCurrent)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		ages, err := youtrack.BuildAging(cfg, issues, time.Now())
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		youtrack.PrintAging(ages)
+	},
+}
+
+func init() {
+	ReportCmd.AddCommand(agingCmd) // ReportCmd is defined in cmd/report/root.go
+
+	addIssueFlags(agingCmd)
+}
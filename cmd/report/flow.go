@@ -0,0 +1,76 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
+var flowCmd = &cobra.Command{
+	Use:   "flow",
+	Short: "Report lead and cycle times of resolved issues",
+	Long: `Computes the lead time (created to resolved) and cycle time (first move to In Progress to
+resolved) of the issues matching --query that were resolved since --since, using the State
+changes in the activity history. Prints percentiles and histograms; use --output csv for the
+per-issue data.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		output, _ := cmd.Flags().GetString("output")
+		if output != "table" && output != "csv" {
+			fmt.Printf("Error: unsupported output '%s' (use 'table' or 'csv')\n", output)
+			return
+		}
+
+		now := time.Now()
+		since := now.AddDate(0, -3, 0)
+		if s, _ := cmd.Flags().GetString("since"); s != "" {
+			if since, err = youtrack.ParseDate(s, now); err != nil {
+				fmt.Printf("Error: %v\n", err)
+				return
+			}
+		}
+
+		query, _ := cmd.Flags().GetString("query")
+		query = strings.TrimSpace(query + " resolved date: " + since.Format("2006-01-02") + " .. Today")
+		issues, err := youtrack.SearchIssues(cfg, query)
+		if err != nil {
+			fmt.Printf("Error fetching issues: %v\n", err)
+			return
+		}
+
+		startState, _ := cmd.Flags().GetString("start-state")
+		flows, err := youtrack.BuildFlow(cfg, issues, startState)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		if output == "csv" {
+			if err := youtrack.WriteFlowCSV(os.Stdout, flows); err != nil {
+				fmt.Printf("Error writing CSV: %v\n", err)
+			}
+			return
+		}
+		youtrack.PrintFlow(flows, startState)
+	},
+}
+
+func init() {
+	ReportCmd.AddCommand(flowCmd) // ReportCmd is defined in cmd/report/root.go
+
+	flowCmd.Flags().StringP("query", "q", "", "YouTrack query selecting the issues (e.g., \"project: DP\")")
+	flowCmd.Flags().String("since", "", "Only issues resolved on or after this day (YYYY-MM-DD, today, yesterday; default: three months ago)")
+	flowCmd.Flags().String("start-state", "In Progress", "State that starts the cycle time")
+	flowCmd.Flags().StringP("output", "o", "table", "Output format: table or csv")
+}
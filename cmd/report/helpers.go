@@ -0,0 +1,58 @@
+package report
+
+import (
+	"fmt"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
+// issuesFromFlags fetches the issues selected by the --sprint (with --board) or --query flag.
+// Without either flag the defaultSprint is used; when that is empty too, one is required.
+func issuesFromFlags(cmd *cobra.Command, cfg config.Config, defaultSprint string) ([]youtrack.Issue, error) {
+	sprintName, _ := cmd.Flags().GetString("sprint")
+	query, _ := cmd.Flags().GetString("query")
+	if sprintName != "" && query != "" {
+		return nil, fmt.Errorf("use either --sprint or --query, not both")
+	}
+	if sprintName == "" && query == "" {
+		if defaultSprint == "" {
+			return nil, fmt.Errorf("select issues with --sprint or --query")
+		}
+		sprintName = defaultSprint
+	}
+
+	if query != "" {
+		issues, err := youtrack.SearchIssues(cfg, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch issues: %w", err)
+		}
+		return issues, nil
+	}
+
+	boardName, _ := cmd.Flags().GetString("board")
+	if boardName == "" {
+		boardName = cfg.BoardName
+	}
+	if boardName == "" {
+		return nil, fmt.Errorf("board name not specified. Please use the --board flag or set a default board using 'youtrack-cli config set board [board_name]'")
+	}
+
+	board, sprint, err := youtrack.ResolveSprint(cfg, boardName, sprintName)
+	if err != nil {
+		return nil, err
+	}
+	issues, err := youtrack.FetchSprintIssues(cfg, board, sprint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch issues for sprint '%s': %w", sprint.Name, err)
+	}
+	return issues, nil
+}
+
+// addIssueFlags defines the --sprint, --board and --query flags read by issuesFromFlags.
+func addIssueFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("sprint", "s", "", "Sprint to report on (a name, or 'current', 'next', 'previous')")
+	cmd.Flags().StringP("board", "b", "", "Board the sprint belongs to (default: configured board)")
+	cmd.Flags().StringP("query", "q", "", "YouTrack query selecting the issues to report on")
+}
@@ -0,0 +1,11 @@
+package report
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var ReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Reports on logged time and delivery",
+	Long:  `Commands for aggregating YouTrack data into reports for team leads.`,
+}
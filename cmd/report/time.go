@@ -0,0 +1,126 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
+var timeCmd = &cobra.Command{
+	Use:   "time",
+	Short: "Aggregate logged time by project, type, author, issue or week",
+	Long: `Aggregates the work items logged between --since and --until (default: the current month)
+into a table with totals and percentages. --group-by type groups by work item type (e.g. Development),
+issue-type by the Type field of the issue (e.g. Bug). Use --output csv or json for spreadsheets and scripts.
+
+Unlike 'work list', which shows your own work items, the report covers every author unless --author
+is given, since it is meant for team totals such as --group-by author. Use --author me for your own time.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		output, _ := cmd.Flags().GetString("output")
+		if output != "table" && output != "csv" && output != "json" {
+			fmt.Printf("Error: unsupported output '%s' (use 'table', 'csv' or 'json')\n", output)
+			return
+		}
+
+		groupBy, _ := cmd.Flags().GetString("group-by")
+		if !slices.Contains(youtrack.TimeGroupings, groupBy) {
+			fmt.Printf("Error: unsupported group '%s' (use %s)\n", groupBy, strings.Join(youtrack.TimeGroupings, ", "))
+			return
+		}
+
+		now := time.Now()
+		since, until := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()), now
+		if s, _ := cmd.Flags().GetString("since"); s != "" {
+			if since, err = youtrack.ParseDate(s, now); err != nil {
+				fmt.Printf("Error: %v\n", err)
+				return
+			}
+		}
+		if u, _ := cmd.Flags().GetString("until"); u != "" {
+			if until, err = youtrack.ParseDate(u, now); err != nil {
+				fmt.Printf("Error: %v\n", err)
+				return
+			}
+		}
+		if until.Before(since) {
+			fmt.Println("Error: --until is before --since")
+			return
+		}
+
+		filter := youtrack.WorkItemFilter{Since: since, Until: until}
+		filter.Query, _ = cmd.Flags().GetString("query")
+		if sprintName, _ := cmd.Flags().GetString("sprint"); sprintName != "" {
+			sprint, boardName, err := youtrack.DetermineSprint(cfg, sprintName)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err)
+				return
+			}
+			if boardName == "" {
+				fmt.Println("Error: board name is not configured, cannot filter by sprint. Use 'youtrack-cli config set board ...'")
+				return
+			}
+			filter.Query = strings.TrimSpace(filter.Query + " " + youtrack.SprintQuery(boardName, sprint))
+		}
+		switch author, _ := cmd.Flags().GetString("author"); author {
+		case "", "all":
+		case "me":
+			user, err := youtrack.CurrentUser(cfg)
+			if err != nil {
+				fmt.Printf("Error fetching current user: %v\n", err)
+				return
+			}
+			filter.Author = user.Login
+		default:
+			filter.Author = author
+		}
+
+		items, err := youtrack.FetchWorkItems(cfg, filter)
+		if err != nil {
+			fmt.Printf("Error fetching work items: %v\n", err)
+			return
+		}
+
+		report, err := youtrack.BuildTimeReport(items, groupBy, since, until)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		switch output {
+		case "csv":
+			err = youtrack.WriteTimeReportCSV(os.Stdout, report)
+		case "json":
+			err = youtrack.WriteTimeReportJSON(os.Stdout, report)
+		default:
+			youtrack.PrintTimeReport(report)
+		}
+		if err != nil {
+			fmt.Printf("Error writing report: %v\n", err)
+		}
+	},
+}
+
+func init() {
+	ReportCmd.AddCommand(timeCmd) // ReportCmd is defined in cmd/report/root.go
+
+	timeCmd.Flags().String("since", "", "First day to include (YYYY-MM-DD, today, yesterday; default: first day of this month)")
+	timeCmd.Flags().String("until", "", "Last day to include (YYYY-MM-DD, today, yesterday; default: today)")
+	timeCmd.Flags().StringP("group-by", "g", "issue", "Group by "+strings.Join(youtrack.TimeGroupings, ", "))
+	timeCmd.Flags().StringP("author", "a", "all", "Author login, 'me' or 'all' (the whole team, unlike 'work list')")
+	timeCmd.Flags().StringP("query", "q", "", "Only include work items on issues matching this YouTrack query")
+	timeCmd.Flags().StringP("sprint", "s", "", "Only include work items on issues of this sprint on the configured board (a name, or 'current', 'next', 'previous')")
+	timeCmd.Flags().StringP("output", "o", "table", "Output format: table, csv or json")
+}
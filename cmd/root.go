@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"youtrack-cli/cmd/config" // Import config package
+	"youtrack-cli/cmd/report" // Import report package
 	"youtrack-cli/cmd/work"   // Import work package
 )
 
@@ -34,7 +35,11 @@ func init() {
 	rootCmd.AddCommand(boardCmd)
 	rootCmd.AddCommand(sprintCmd)
 	rootCmd.AddCommand(listCmd)
-	rootCmd.AddCommand(work.WorkCmd) // Add the work root command
+	rootCmd.AddCommand(tuiCmd)
+	rootCmd.AddCommand(syncCmd)
+	rootCmd.AddCommand(standupCmd)
+	rootCmd.AddCommand(work.WorkCmd)     // Add the work root command
+	rootCmd.AddCommand(report.ReportCmd) // Add the report root command
 
 	// Here you will define your flags and configuration settings.
 	// Cobra supports persistent flags, which, if defined here,
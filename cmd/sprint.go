@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 	"youtrack-cli/internal/config"
 	"youtrack-cli/internal/youtrack"
 
@@ -17,7 +18,8 @@ var sprintCmd = &cobra.Command{
 var sprintListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List sprints for a specific board",
-	Long:  `Lists all sprints for a specified YouTrack board. Uses the default board from config if not specified.`,
+	Long:  `Lists all sprints for a specified YouTrack board. Uses the default board from config if not specified.
+The sprints that the current, next and previous keywords resolve to are marked.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		cfg, err := config.Load()
 		if err != nil {
@@ -35,20 +37,260 @@ var sprintListCmd = &cobra.Command{
 			return
 		}
 
-		sprints, err := youtrack.ListSprints(cfg, boardName)
+		board, err := youtrack.FindBoard(cfg, boardName)
 		if err != nil {
 			fmt.Printf("Error listing sprints for board '%s': %v\n", boardName, err)
 			return
 		}
 
-		youtrack.PrintSprints(boardName, sprints)
+		sprints, err := youtrack.ListBoardSprints(cfg, board)
+		if err != nil {
+			fmt.Printf("Error listing sprints for board '%s': %v\n", boardName, err)
+			return
+		}
+
+		youtrack.PrintSprints(board, sprints)
+	},
+}
+
+var sprintShowCmd = &cobra.Command{
+	Use:   "show [name]",
+	Short: "Show progress of a sprint",
+	Long: `Shows dates, goal, days remaining, issue counts per state, estimation versus spent time
+and per-assignee load for a sprint. The sprint can be a name or one of the keywords
+current, next and previous; without it the default sprint from config or the board's current sprint is used.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		board, sprint, err := resolveSprintArg(cmd, cfg, args)
+		if err != nil {
+			fmt.Printf("Error resolving sprint: %v\n", err)
+			return
+		}
+
+		issues, err := youtrack.FetchSprintIssues(cfg, board, sprint)
+		if err != nil {
+			fmt.Printf("Error fetching issues for sprint '%s': %v\n", sprint.Name, err)
+			return
+		}
+
+		youtrack.PrintSprintSummary(board.Name, youtrack.SummarizeSprint(sprint, issues))
+	},
+}
+
+var sprintBurndownCmd = &cobra.Command{
+	Use:   "burndown [name]",
+	Short: "Show a burndown chart for a sprint",
+	Long: `Reconstructs the remaining estimation per day from issue activities and resolved dates
+and renders it in the terminal next to the ideal line. Use --output csv for the raw series.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runBurnChart(cmd, args, youtrack.PrintBurndownChart)
+	},
+}
+
+var sprintBurnupCmd = &cobra.Command{
+	Use:   "burnup [name]",
+	Short: "Show a burnup chart for a sprint",
+	Long: `Reconstructs the completed estimation and total scope per day from issue activities and
+resolved dates and renders them in the terminal. Use --output csv for the raw series.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runBurnChart(cmd, args, youtrack.PrintBurnupChart)
+	},
+}
+
+var sprintVelocityCmd = &cobra.Command{
+	Use:   "velocity",
+	Short: "Show velocity across past sprints",
+	Long: `For each closed sprint on the board, sums the completed estimation and issue count,
+then shows the average, the trend and a suggested capacity for the next sprint.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		last, _ := cmd.Flags().GetInt("last")
+		if last <= 0 {
+			fmt.Println("Error: --last must be at least 1")
+			return
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		boardName, err := boardFromFlag(cmd, cfg)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		board, err := youtrack.FindBoard(cfg, boardName)
+		if err != nil {
+			fmt.Printf("Error finding board '%s': %v\n", boardName, err)
+			return
+		}
+
+		velocities, err := youtrack.BuildVelocity(cfg, board, last)
+		if err != nil {
+			fmt.Printf("Error computing velocity: %v\n", err)
+			return
+		}
+
+		youtrack.PrintVelocity(board.Name, velocities, youtrack.SummarizeVelocity(velocities))
+	},
+}
+
+var sprintPlanCmd = &cobra.Command{
+	Use:   "plan [name]",
+	Short: "Compare sprint estimation against team capacity",
+	Long: `Groups the estimation of a sprint by assignee and compares it with each person's capacity
+from the config (hours per day and days off over the sprint's working days), flagging
+overcommitted people and unassigned work.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+		if err := cfg.Calendar.Validate(); err != nil {
+			fmt.Printf("Error in configuration: %v\n", err)
+			return
+		}
+
+		board, sprint, err := resolveSprintArg(cmd, cfg, args)
+		if err != nil {
+			fmt.Printf("Error resolving sprint: %v\n", err)
+			return
+		}
+
+		issues, err := youtrack.FetchSprintIssues(cfg, board, sprint)
+		if err != nil {
+			fmt.Printf("Error fetching issues for sprint '%s': %v\n", sprint.Name, err)
+			return
+		}
+
+		youtrack.PrintSprintPlan(youtrack.PlanSprint(cfg, sprint, issues))
+	},
+}
+
+var sprintReportCmd = &cobra.Command{
+	Use:   "report [name]",
+	Short: "Generate a sprint review document",
+	Long: `Generates a sprint review document from YouTrack data: completed and not completed issues,
+scope changes after the first day, estimation and spent time totals, per-person contribution
+and the burndown as an inline SVG chart. Use --output md (default) or html.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		output, _ := cmd.Flags().GetString("output")
+		if output != "md" && output != "html" {
+			fmt.Printf("Error: unsupported output '%s' (use 'md' or 'html')\n", output)
+			return
+		}
+
+		board, sprint, err := resolveSprintArg(cmd, cfg, args)
+		if err != nil {
+			fmt.Printf("Error resolving sprint: %v\n", err)
+			return
+		}
+
+		issues, err := youtrack.FetchSprintIssues(cfg, board, sprint)
+		if err != nil {
+			fmt.Printf("Error fetching issues for sprint '%s': %v\n", sprint.Name, err)
+			return
+		}
+
+		report, err := youtrack.BuildSprintReport(cfg, board, sprint, issues)
+		if err != nil {
+			fmt.Printf("Error building sprint report: %v\n", err)
+			return
+		}
+
+		if output == "html" {
+			youtrack.WriteSprintReportHTML(os.Stdout, report)
+			return
+		}
+		youtrack.WriteSprintReportMarkdown(os.Stdout, report)
 	},
 }
 
+// runBurnChart builds the burndown series of a sprint and prints it as a chart or CSV.
+func runBurnChart(cmd *cobra.Command, args []string, printChart func(youtrack.Sprint, []youtrack.BurndownPoint)) {
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error loading configuration: %v\n", err)
+		return
+	}
+
+	output, _ := cmd.Flags().GetString("output")
+	if output != "chart" && output != "csv" {
+		fmt.Printf("Error: unsupported output '%s' (use 'chart' or 'csv')\n", output)
+		return
+	}
+
+	board, sprint, err := resolveSprintArg(cmd, cfg, args)
+	if err != nil {
+		fmt.Printf("Error resolving sprint: %v\n", err)
+		return
+	}
+
+	issues, err := youtrack.FetchSprintIssues(cfg, board, sprint)
+	if err != nil {
+		fmt.Printf("Error fetching issues for sprint '%s': %v\n", sprint.Name, err)
+		return
+	}
+
+	points, err := youtrack.BuildBurndown(cfg, board, sprint, issues)
+	if err != nil {
+		fmt.Printf("Error building burndown: %v\n", err)
+		return
+	}
+
+	if output == "csv" {
+		if err := youtrack.WriteBurndownCSV(os.Stdout, points); err != nil {
+			fmt.Printf("Error writing CSV: %v\n", err)
+		}
+		return
+	}
+	printChart(sprint, points)
+}
+
 func init() {
 	// rootCmd.AddCommand(sprintCmd) // REMOVED: Added in cmd/root.go
 	sprintCmd.AddCommand(sprintListCmd)
 
 	// Define flags for the sprint list command
 	sprintListCmd.Flags().StringP("board", "b", "", "Board name to list sprints from")
+
+	sprintCmd.AddCommand(sprintShowCmd)
+	sprintShowCmd.Flags().StringP("board", "b", "", "Board name the sprint belongs to")
+
+	sprintCmd.AddCommand(sprintPlanCmd)
+	sprintPlanCmd.Flags().StringP("board", "b", "", "Board name the sprint belongs to")
+
+	sprintCmd.AddCommand(sprintVelocityCmd)
+	sprintVelocityCmd.Flags().StringP("board", "b", "", "Board name to compute velocity for")
+	sprintVelocityCmd.Flags().IntP("last", "n", 6, "Number of closed sprints to include")
+
+	sprintCmd.AddCommand(sprintReportCmd)
+	sprintReportCmd.Flags().StringP("board", "b", "", "Board name the sprint belongs to")
+	sprintReportCmd.Flags().StringP("output", "o", "md", "Output format: md or html")
+
+	for _, c := range []*cobra.Command{sprintBurndownCmd, sprintBurnupCmd} {
+		sprintCmd.AddCommand(c)
+		c.Flags().StringP("board", "b", "", "Board name the sprint belongs to")
+		c.Flags().StringP("output", "o", "chart", "Output format: chart or csv")
+	}
 }
\ No newline at end of file
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
+var standupCmd = &cobra.Command{
+	Use:   "standup",
+	Short: "Print a standup summary in Markdown",
+	Long: `Collects the issues you logged work on, State changes of your issues and the comments you
+wrote since --since (default: the previous workday), plus your issues that are in progress or
+blocked, and prints a ready-to-paste Markdown summary (yesterday / today / blockers).`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+		if err := cfg.Calendar.Validate(); err != nil {
+			fmt.Printf("Error in configuration: %v\n", err)
+			return
+		}
+
+		now := time.Now()
+		since, _ := youtrack.ParseDate("yesterday", now)
+		for !cfg.IsWorkday(since) {
+			since = since.AddDate(0, 0, -1)
+		}
+		if s, _ := cmd.Flags().GetString("since"); s != "" {
+			if since, err = youtrack.ParseDate(s, now); err != nil {
+				fmt.Printf("Error: %v\n", err)
+				return
+			}
+		}
+
+		progressStates, _ := cmd.Flags().GetStringSlice("progress-state")
+		blockedStates, _ := cmd.Flags().GetStringSlice("blocked-state")
+		standup, err := youtrack.BuildStandup(cfg, since, progressStates, blockedStates)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		youtrack.WriteStandupMarkdown(os.Stdout, standup)
+	},
+}
+
+func init() {
+	standupCmd.Flags().String("since", "", "First day to include (YYYY-MM-DD, today, yesterday; default: previous workday)")
+	standupCmd.Flags().StringSlice("progress-state", []string{"In Progress"}, "States listed under Today")
+	standupCmd.Flags().StringSlice("blocked-state", []string{"Blocked"}, "States listed under Blockers")
+}
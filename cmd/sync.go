@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Send changes queued while YouTrack was unreachable",
+	Long: `Added, edited and deleted work items are queued locally when YouTrack cannot be reached.
+This command replays them in the order they were made and stops at the first failure.
+
+A replay that times out or loses the connection mid-request is marked "possibly sent", since
+YouTrack may have applied it, and is checked before it is sent again. A work item is matched by
+issue, day, duration, description, type and attributes and only added again when YouTrack has no
+more matching work items than before the first attempt. A deletion is skipped when the work item
+is gone and an update is sent again. For other operations queued by earlier versions, such as
+comments, you are asked before they are sent again.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		reader := bufio.NewReader(os.Stdin)
+		confirm := func(op youtrack.Operation) bool {
+			fmt.Printf("'%s' was possibly sent already (%s). Send it again? [y/N] ", op.Summary, op.LastError)
+			answer, _ := reader.ReadString('\n')
+			answer = strings.ToLower(strings.TrimSpace(answer))
+			return answer == "y" || answer == "yes"
+		}
+
+		result, err := youtrack.SyncOutbox(cfg, confirm)
+		for _, op := range result.Sent {
+			fmt.Printf("Sent: %s\n", op.Summary)
+		}
+		for _, op := range result.Skipped {
+			fmt.Printf("Already in YouTrack: %s\n", op.Summary)
+		}
+		if err != nil {
+			fmt.Printf("Error syncing: %v\n", err)
+			return
+		}
+		if result.Held != nil {
+			fmt.Printf("Kept: %s. Check YouTrack, then drop it with 'youtrack-cli sync drop %s' if it was applied or run sync again to send it.\n",
+				result.Held.Summary, result.Held.Key[:8])
+			fmt.Printf("%d operations still pending.\n", result.Remaining)
+			return
+		}
+		if result.Failed != nil {
+			fmt.Printf("Failed: %s: %s\n", result.Failed.Summary, result.Failed.LastError)
+			fmt.Printf("%d operations still pending. Fix the problem and run sync again, or drop the operation with 'youtrack-cli sync drop %s'.\n",
+				result.Remaining, result.Failed.Key[:8])
+			return
+		}
+		if len(result.Sent)+len(result.Skipped) == 0 {
+			fmt.Println("No pending operations.")
+			return
+		}
+		fmt.Println("All pending operations synced.")
+	},
+}
+
+var syncStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "List operations waiting to be synced",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ops, err := youtrack.PendingOperations()
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		youtrack.PrintOutbox(ops)
+	},
+}
+
+var syncDropCmd = &cobra.Command{
+	Use:   "drop [key]",
+	Short: "Remove a queued operation without sending it",
+	Long:  `Removes a queued operation. Keys (or a unique prefix) are shown by 'youtrack-cli sync status'.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		op, err := youtrack.DropOperation(args[0])
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		fmt.Printf("Dropped: %s\n", op.Summary)
+	},
+}
+
+func init() {
+	syncCmd.AddCommand(syncStatusCmd)
+	syncCmd.AddCommand(syncDropCmd)
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/tui"
+
+	"github.com/spf13/cobra"
+)
+
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Browse and act on issues in a full-screen terminal UI",
+	Long: `Opens a full-screen issue browser driven by the same query logic as 'list'.
+Keys: ↑/↓ or j/k move, / fuzzy filter, s change state, w log work, c comment,
+S switch sprint, r refresh, q quit. Requires a Unix terminal with stty; not available on Windows.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		sprintName, _ := cmd.Flags().GetString("sprint")
+		assigneeName, _ := cmd.Flags().GetString("assignee")
+		issueType, _ := cmd.Flags().GetString("type")
+
+		opts := tui.Options{Sprint: sprintName, Assignee: assigneeName, Type: issueType}
+		if err := tui.Run(cfg, opts); err != nil {
+			fmt.Printf("Error running TUI: %v\n", err)
+		}
+	},
+}
+
+func init() {
+	// Same filters as the list command
+	tuiCmd.Flags().StringP("sprint", "s", "", "Sprint to start with (a name, or 'current', 'next', 'previous')")
+	tuiCmd.Flags().StringP("assignee", "a", "", "Assignee to list issues for (e.g., 'me', 'unassigned', or a username)")
+	tuiCmd.Flags().StringP("type", "t", "", "Filter issues by Type (e.g., 'Task', 'Bug', 'Story')")
+}
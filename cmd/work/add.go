@@ -1,7 +1,9 @@
 package work
 
 import (
+	"errors"
 	"fmt"
+	"time"
 	"youtrack-cli/internal/config"
 	"youtrack-cli/internal/youtrack"
 
@@ -9,9 +11,15 @@ import (
 )
 
 var addCmd = &cobra.Command{
-	Use:   "add [issue-id] [minutes] [description]",
+	Use:   "add [issue-id] [duration] [description]",
 	Short: "Add a work item to a YouTrack issue",
-	Args:  cobra.ExactArgs(3),
+	Long: `Adds a work item to a YouTrack issue. The duration accepts minutes (90) or units
+such as 45m, 1h30m, 1.5h and 2d. A day is the server-wide length of a working day from YouTrack's
+time tracking settings (hours_per_day from the config when they cannot be read): YouTrack has no
+per-project day length, so 1d counts the same in every project.
+Use --date to backfill, --type to set the work item type and --attr to set work item
+attributes; types and attributes are checked against the project's time tracking settings.`,
+	Args: cobra.RangeArgs(2, 3),
 	Run: func(cmd *cobra.Command, args []string) {
 		cfg, err := config.Load()
 		if err != nil {
@@ -20,18 +28,82 @@ var addCmd = &cobra.Command{
 		}
 
 		issueID := args[0]
-		minutes := args[1]
-		description := args[2]
+		description := ""
+		if len(args) > 2 {
+			description = args[2]
+		}
 
-		err = youtrack.AddWorkItem(cfg, issueID, minutes, description)
+		// Validate everything locally before anything is sent
+		item, err := newWorkItemFromFlags(cmd, cfg, issueID, args[1], description)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		err = youtrack.AddWorkItem(cfg, issueID, item)
+		if errors.Is(err, youtrack.ErrQueued) {
+			fmt.Printf("YouTrack is unreachable, queued %s on %s (%s). Run 'youtrack-cli sync' when back online.\n",
+				youtrack.HumanizeDuration(item.Duration), issueID, item.Date.Format("2006-01-02"))
+			return
+		}
 		if err != nil {
 			fmt.Printf("Error adding work item: %v\n", err)
 			return
 		}
-		fmt.Println("Work item added successfully.")
+		fmt.Printf("Work item added successfully (%s on %s).\n", youtrack.HumanizeDuration(item.Duration), item.Date.Format("2006-01-02"))
 	},
 }
 
+// newWorkItemFromFlags parses the duration argument and the --date, --type and --attr flags.
+func newWorkItemFromFlags(cmd *cobra.Command, cfg config.Config, issueID, duration, description string) (youtrack.NewWorkItem, error) {
+	item := youtrack.NewWorkItem{Description: description}
+	now := time.Now()
+
+	d, warning, err := youtrack.ParseWorkDuration(cfg, duration)
+	if err != nil {
+		return item, err
+	}
+	if warning != "" {
+		fmt.Printf("Warning: %s\n", warning)
+	}
+	item.Duration = d
+
+	date, _ := cmd.Flags().GetString("date")
+	item.Date, err = youtrack.ParseDate(date, now)
+	if err != nil {
+		return item, err
+	}
+	if item.Date.After(now) {
+		return item, fmt.Errorf("date %s is in the future", item.Date.Format("2006-01-02"))
+	}
+
+	typeName, _ := cmd.Flags().GetString("type")
+	attrs, _ := cmd.Flags().GetStringArray("attr")
+	if typeName == "" && len(attrs) == 0 {
+		return item, nil
+	}
+
+	_, settings, err := youtrack.IssueTimeTracking(cfg, issueID)
+	if err != nil {
+		return item, err
+	}
+	if typeName != "" {
+		t, err := youtrack.FindWorkItemType(settings.WorkItemTypes, typeName)
+		if err != nil {
+			return item, err
+		}
+		item.Type = &t
+	}
+	if item.Attributes, err = youtrack.ResolveWorkItemAttributes(settings.Attributes, attrs); err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
 func init() {
 	WorkCmd.AddCommand(addCmd) // WorkCmd is defined in cmd/work/root.go
-}
\ No newline at end of file
+
+	addCmd.Flags().String("date", "today", "Day the work was done (YYYY-MM-DD, today, yesterday)")
+	addCmd.Flags().StringP("type", "t", "", "Work item type (e.g., Development)")
+	addCmd.Flags().StringArray("attr", nil, "Work item attribute as key=value (e.g., Billing=Client A), repeatable")
+}
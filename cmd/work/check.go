@@ -2,6 +2,8 @@ package work
 
 import (
 	"fmt"
+	"os"
+	"time"
 	"youtrack-cli/internal/config"
 	"youtrack-cli/internal/youtrack"
 
@@ -10,32 +12,60 @@ import (
 
 var checkCmd = &cobra.Command{
 	Use:   "check",
-	Short: "Check for issues with no work logged today",
-	Long:  `Checks for YouTrack issues assigned to you that have no work logged for the current day.`,
+	Short: "Check that enough work is logged per day",
+	Long: `Compares the total time you logged on each of the last completed workdays against the
+daily_hours target from the config. Today is not finished yet and is only checked with
+--include-today. Days that are not workdays of the configured calendar and configured days off
+are skipped, and days follow the local time zone.
+
+Exit status, for use in shell prompts and hooks:
+  0  every checked workday meets the target
+  1  at least one workday is under target
+
+Errors such as an unreachable YouTrack are printed like in other commands and do not change the
+exit status, so a prompt does not report missing time it could not check.`,
+	Args: cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
 		cfg, err := config.Load()
 		if err != nil {
 			fmt.Printf("Error loading configuration: %v\n", err)
 			return
 		}
+		if err := cfg.Calendar.Validate(); err != nil {
+			fmt.Printf("Error in configuration: %v\n", err)
+			return
+		}
 
-		issuesWithoutWork, err := youtrack.CheckWork(cfg)
+		days, _ := cmd.Flags().GetInt("days")
+		includeToday, _ := cmd.Flags().GetBool("include-today")
+		logs, err := youtrack.CheckWork(cfg, days, includeToday, time.Now())
 		if err != nil {
 			fmt.Printf("Error checking work: %v\n", err)
 			return
 		}
 
-		if len(issuesWithoutWork) > 0 {
-			fmt.Println("You have not logged work for the following issues today:")
-			for _, issue := range issuesWithoutWork {
-				fmt.Println("- ", issue)
+		under := 0
+		for _, log := range logs {
+			status := "ok"
+			if log.UnderTarget() {
+				status = fmt.Sprintf("⚠ %s missing", youtrack.HumanizeDuration(log.Target-log.Logged))
+				under++
 			}
-		} else {
-			fmt.Println("All issues have work logged for today.")
+			fmt.Printf("%s\t%-8s / %-8s\t%s\n", log.Day.Format("Mon 2006-01-02"),
+				youtrack.HumanizeDuration(log.Logged), youtrack.HumanizeDuration(log.Target), status)
 		}
+
+		if under > 0 {
+			fmt.Printf("\n%d of %d workdays under target.\n", under, len(logs))
+			os.Exit(1)
+		}
+		fmt.Println("\nAll workdays meet the target.")
 	},
 }
 
 func init() {
 	WorkCmd.AddCommand(checkCmd) // WorkCmd is defined in cmd/work/root.go
-}
\ No newline at end of file
+
+	checkCmd.Flags().Int("days", 1, "Number of workdays to check, counting back from yesterday")
+	checkCmd.Flags().Bool("include-today", false, "Also check today, counting back from today")
+}
@@ -0,0 +1,54 @@
+package work
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete [issue-id] [item-id]",
+	Short: "Delete a work item",
+	Long:  `Deletes a work item from an issue. Item IDs are shown by 'youtrack-cli work list'.`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		issueID, itemID := args[0], args[1]
+		if yes, _ := cmd.Flags().GetBool("yes"); !yes {
+			fmt.Printf("Delete work item %s from %s? [y/N] ", itemID, issueID)
+			answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+			if a := strings.ToLower(strings.TrimSpace(answer)); a != "y" && a != "yes" {
+				fmt.Println("Aborted.")
+				return
+			}
+		}
+
+		err = youtrack.DeleteWorkItem(cfg, issueID, itemID)
+		if errors.Is(err, youtrack.ErrQueued) {
+			fmt.Println("YouTrack is unreachable, the deletion was queued. Run 'youtrack-cli sync' when back online.")
+			return
+		}
+		if err != nil {
+			fmt.Printf("Error deleting work item: %v\n", err)
+			return
+		}
+		fmt.Println("Work item deleted successfully.")
+	},
+}
+
+func init() {
+	WorkCmd.AddCommand(deleteCmd) // WorkCmd is defined in cmd/work/root.go
+
+	deleteCmd.Flags().BoolP("yes", "y", false, "Delete without asking for confirmation")
+}
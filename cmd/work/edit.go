@@ -0,0 +1,66 @@
+package work
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
+var editCmd = &cobra.Command{
+	Use:   "edit [issue-id] [item-id]",
+	Short: "Edit the duration or text of a work item",
+	Long: `Changes an existing work item. Item IDs are shown by 'youtrack-cli work list'.
+The duration accepts the same formats as 'work add' (90, 45m, 1h30m, 1.5h, 2d); a day is the
+server-wide working day length, not a per-project one.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		var update youtrack.WorkItemUpdate
+		if duration, _ := cmd.Flags().GetString("duration"); duration != "" {
+			d, warning, err := youtrack.ParseWorkDuration(cfg, duration)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err)
+				return
+			}
+			if warning != "" {
+				fmt.Printf("Warning: %s\n", warning)
+			}
+			update.Duration = fmt.Sprintf("%dm", int(d/time.Minute))
+		}
+		if cmd.Flags().Changed("text") {
+			text, _ := cmd.Flags().GetString("text")
+			update.Text = &text
+		}
+		if update.Duration == "" && update.Text == nil {
+			fmt.Println("Error: nothing to change, use --duration and/or --text")
+			return
+		}
+
+		err = youtrack.UpdateWorkItem(cfg, args[0], args[1], update)
+		if errors.Is(err, youtrack.ErrQueued) {
+			fmt.Println("YouTrack is unreachable, the update was queued. Run 'youtrack-cli sync' when back online.")
+			return
+		}
+		if err != nil {
+			fmt.Printf("Error updating work item: %v\n", err)
+			return
+		}
+		fmt.Println("Work item updated successfully.")
+	},
+}
+
+func init() {
+	WorkCmd.AddCommand(editCmd) // WorkCmd is defined in cmd/work/root.go
+
+	editCmd.Flags().StringP("duration", "d", "", "New duration (e.g., 90, 45m, 1h30m, 1.5h)")
+	editCmd.Flags().StringP("text", "m", "", "New description")
+}
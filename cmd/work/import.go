@@ -0,0 +1,53 @@
+package work
+
+import (
+	"fmt"
+	"time"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import work items from a CSV or JSON file",
+	Long: `Imports work items from a CSV file with a header row or a JSON array of objects, both with
+the columns date, issue, duration, description and type (date, issue and duration are required).
+Every row is validated before anything is sent, and rows matching a work item you already logged
+(same issue, day, duration and description) are skipped. Use --dry-run to preview the result.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		rows, err := youtrack.ReadImportFile(args[0])
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		if warning := youtrack.ValidateImport(cfg, rows, time.Now()); warning != "" {
+			fmt.Printf("Warning: %s\n", warning)
+		}
+		if err := youtrack.MarkImportDuplicates(cfg, rows); err != nil {
+			fmt.Printf("Error checking for duplicates: %v\n", err)
+			return
+		}
+
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if !dryRun {
+			youtrack.RunImport(cfg, rows)
+		}
+		youtrack.PrintImportReport(rows, dryRun)
+	},
+}
+
+func init() {
+	WorkCmd.AddCommand(importCmd) // WorkCmd is defined in cmd/work/root.go
+
+	importCmd.Flags().Bool("dry-run", false, "Validate and preview the import without adding work items")
+}
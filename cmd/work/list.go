@@ -0,0 +1,104 @@
+package work
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List logged work items",
+	Long: `Lists work items with their date, issue, duration, type, attributes and text, followed by totals per day
+and per issue. Defaults to your own work items; use --author all for everyone.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		filter, err := workItemFilterFromFlags(cmd, cfg)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		items, err := youtrack.FetchWorkItems(cfg, filter)
+		if err != nil {
+			fmt.Printf("Error fetching work items: %v\n", err)
+			return
+		}
+
+		youtrack.PrintWorkItems(items)
+	},
+}
+
+// workItemFilterFromFlags builds a work item filter from the --issue, --since, --until, --author and --sprint flags.
+func workItemFilterFromFlags(cmd *cobra.Command, cfg config.Config) (youtrack.WorkItemFilter, error) {
+	var filter youtrack.WorkItemFilter
+	now := time.Now()
+
+	if since, _ := cmd.Flags().GetString("since"); since != "" {
+		t, err := youtrack.ParseDate(since, now)
+		if err != nil {
+			return filter, err
+		}
+		filter.Since = t
+	}
+	if until, _ := cmd.Flags().GetString("until"); until != "" {
+		t, err := youtrack.ParseDate(until, now)
+		if err != nil {
+			return filter, err
+		}
+		filter.Until = t
+	}
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && filter.Until.Before(filter.Since) {
+		return filter, fmt.Errorf("--until is before --since")
+	}
+
+	var query []string
+	if issueID, _ := cmd.Flags().GetString("issue"); issueID != "" {
+		query = append(query, "issue id: "+issueID)
+	}
+	if sprintName, _ := cmd.Flags().GetString("sprint"); sprintName != "" {
+		if cfg.BoardName == "" {
+			return filter, fmt.Errorf("board name is not configured, cannot filter by sprint. Use 'youtrack-cli config set board ...'")
+		}
+		sprint, boardName, err := youtrack.DetermineSprint(cfg, sprintName)
+		if err != nil {
+			return filter, err
+		}
+		query = append(query, youtrack.SprintQuery(boardName, sprint))
+	}
+	filter.Query = strings.Join(query, " ")
+
+	author, _ := cmd.Flags().GetString("author")
+	switch author {
+	case "", "all":
+	case "me":
+		user, err := youtrack.CurrentUser(cfg)
+		if err != nil {
+			return filter, fmt.Errorf("failed to fetch current user: %w", err)
+		}
+		filter.Author = user.Login
+	default:
+		filter.Author = author
+	}
+	return filter, nil
+}
+
+func init() {
+	WorkCmd.AddCommand(listCmd) // WorkCmd is defined in cmd/work/root.go
+
+	listCmd.Flags().StringP("issue", "i", "", "Only list work items of this issue (e.g., DP-1)")
+	listCmd.Flags().String("since", "", "First day to include (YYYY-MM-DD, today, yesterday)")
+	listCmd.Flags().String("until", "", "Last day to include (YYYY-MM-DD, today, yesterday)")
+	listCmd.Flags().StringP("author", "a", "me", "Author login, 'me' or 'all'")
+	listCmd.Flags().StringP("sprint", "s", "", "Only list work items on issues of this sprint (a name, or 'current', 'next', 'previous')")
+}
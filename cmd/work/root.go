@@ -7,7 +7,7 @@ import (
 var WorkCmd = &cobra.Command{
 	Use:   "work",
 	Short: "Manage YouTrack work items",
-	Long:  `Commands for adding and checking work items in YouTrack.`,
+	Long:  `Commands for adding, listing and checking work items in YouTrack.`,
 }
 
 func init() {
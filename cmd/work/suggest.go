@@ -0,0 +1,205 @@
+package work
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/gitlog"
+	"youtrack-cli/internal/timer"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
+var suggestCmd = &cobra.Command{
+	Use:   "suggest",
+	Short: "Suggest work items from your git commits",
+	Long: `Scans the commits you made in the local git repository, extracts issue IDs such as DP-123
+of existing projects from commit messages or branch names and estimates the time spent from the gaps between
+consecutive commits. Each suggestion can be accepted, edited or skipped before it is added.
+Issues you already logged time on for the same day are skipped, so running it again does not
+propose the same work twice. The author defaults to git_author from the config, then to git's user.email.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		since, _ := cmd.Flags().GetString("since")
+		start, err := youtrack.ParseDate(since, time.Now())
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		author, _ := cmd.Flags().GetString("author")
+		if author == "" {
+			author = cfg.GitAuthor
+		}
+		if author == "" {
+			if author, err = gitlog.DefaultAuthor(); err != nil {
+				fmt.Printf("Error: %v (set one with --author or 'youtrack-cli config set git_author ...')\n", err)
+				return
+			}
+		}
+
+		commits, err := gitlog.Commits(author, start)
+		if err != nil {
+			fmt.Printf("Error reading git history: %v\n", err)
+			return
+		}
+
+		// Only keys of existing projects count, so "UTF-8" or "SHA-256" are not taken for issues
+		projects, err := youtrack.ListProjects(cfg)
+		if err != nil {
+			fmt.Printf("Error fetching projects: %v\n", err)
+			return
+		}
+		keys := make(map[string]bool)
+		for _, p := range projects {
+			keys[strings.ToUpper(p.ShortName)] = true
+		}
+
+		maxGap, _ := cmd.Flags().GetDuration("max-gap")
+		firstCommit, _ := cmd.Flags().GetDuration("first-commit")
+		roundTo, _ := cmd.Flags().GetDuration("round")
+		suggestions := gitlog.Suggest(commits, keys, maxGap, firstCommit)
+		if len(suggestions) == 0 {
+			fmt.Printf("No commits by %s mentioning an issue since %s.\n", author, start.Format("2006-01-02"))
+			return
+		}
+
+		user, err := youtrack.CurrentUser(cfg)
+		if err != nil {
+			fmt.Printf("Error fetching current user: %v\n", err)
+			return
+		}
+		existing, err := youtrack.FetchWorkItems(cfg, youtrack.WorkItemFilter{
+			Since:  suggestions[0].Day,
+			Until:  suggestions[len(suggestions)-1].Day,
+			Author: user.Login,
+		})
+		if err != nil {
+			fmt.Printf("Error fetching existing work items: %v\n", err)
+			return
+		}
+
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		reader := bufio.NewReader(os.Stdin)
+		var summary suggestSummary
+		for _, s := range suggestions {
+			d := timer.Round(s.Duration, roundTo, "nearest")
+			if d < roundTo {
+				d = roundTo
+			}
+			description := strings.Join(s.Subjects, "; ")
+
+			fmt.Printf("\n%s  %s  %s\n", s.Day.Format("Mon 2006-01-02"), s.IssueID, youtrack.HumanizeDuration(d))
+			for _, subject := range s.Subjects {
+				fmt.Printf("  - %s\n", subject)
+			}
+			if logged := youtrack.LoggedOn(existing, s.IssueID, s.Day); logged > 0 {
+				fmt.Printf("Skipped, %s already logged on %s that day.\n", youtrack.HumanizeDuration(logged), s.IssueID)
+				summary.logged++
+				continue
+			}
+			if dryRun {
+				continue
+			}
+
+			fmt.Print("Add? [y]es / [n]o / [e]dit / [q]uit: ")
+			answer, _ := reader.ReadString('\n')
+			switch strings.ToLower(strings.TrimSpace(answer)) {
+			case "y", "yes":
+			case "e", "edit":
+				if d, description, err = editSuggestion(cfg, reader, d, description); err != nil {
+					fmt.Printf("Error: %v, skipped.\n", err)
+					continue
+				}
+			case "q", "quit":
+				summary.print()
+				return
+			default:
+				fmt.Println("Skipped.")
+				continue
+			}
+
+			item := youtrack.NewWorkItem{Duration: d, Description: description, Date: s.Day}
+			err := youtrack.AddWorkItem(cfg, s.IssueID, item)
+			if errors.Is(err, youtrack.ErrQueued) {
+				fmt.Printf("YouTrack is unreachable, queued %s on %s.\n", youtrack.HumanizeDuration(d), s.IssueID)
+				summary.queued++
+				continue
+			}
+			if err != nil {
+				fmt.Printf("Error adding work item: %v\n", err)
+				continue
+			}
+			fmt.Printf("Added %s on %s.\n", youtrack.HumanizeDuration(d), s.IssueID)
+			summary.added++
+		}
+		if dryRun {
+			if summary.logged > 0 {
+				fmt.Printf("\n%d suggestions already logged.\n", summary.logged)
+			}
+			return
+		}
+		summary.print()
+	},
+}
+
+// suggestSummary counts the outcome of the suggestions.
+type suggestSummary struct {
+	added, queued, logged int
+}
+
+// print prints how many work items were added and, when any, queued or skipped as already logged.
+func (s suggestSummary) print() {
+	fmt.Printf("\n%d work items added.\n", s.added)
+	if s.queued > 0 {
+		fmt.Printf("%d work items queued, run 'youtrack-cli sync' when back online.\n", s.queued)
+	}
+	if s.logged > 0 {
+		fmt.Printf("%d suggestions skipped as already logged.\n", s.logged)
+	}
+}
+
+// editSuggestion asks for a new duration and description, keeping the current values on empty input.
+func editSuggestion(cfg config.Config, reader *bufio.Reader, d time.Duration, description string) (time.Duration, string, error) {
+	fmt.Printf("Duration [%s]: ", youtrack.HumanizeDuration(d))
+	answer, _ := reader.ReadString('\n')
+	if answer = strings.TrimSpace(answer); answer != "" {
+		parsed, warning, err := youtrack.ParseWorkDuration(cfg, answer)
+		if err != nil {
+			return 0, "", err
+		}
+		if warning != "" {
+			fmt.Printf("Warning: %s\n", warning)
+		}
+		d = parsed
+	}
+
+	fmt.Printf("Description [%s]: ", description)
+	answer, _ = reader.ReadString('\n')
+	if answer = strings.TrimSpace(answer); answer != "" {
+		description = answer
+	}
+	return d, description, nil
+}
+
+func init() {
+	WorkCmd.AddCommand(suggestCmd) // WorkCmd is defined in cmd/work/root.go
+
+	suggestCmd.Flags().String("since", "yesterday", "First day to scan (YYYY-MM-DD, today, yesterday)")
+	suggestCmd.Flags().StringP("author", "a", "", "Commit author to scan for (default: git_author from config, then git user.email)")
+	suggestCmd.Flags().Duration("max-gap", 2*time.Hour, "Longest gap between commits still counted as continuous work")
+	suggestCmd.Flags().Duration("first-commit", 30*time.Minute, "Time credited to the first commit after a longer gap")
+	suggestCmd.Flags().Duration("round", 15*time.Minute, "Round suggested durations to this unit")
+	suggestCmd.Flags().Bool("dry-run", false, "Only print the suggestions")
+}
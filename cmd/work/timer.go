@@ -0,0 +1,210 @@
+package work
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/timer"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
+var startCmd = &cobra.Command{
+	Use:   "start [issue-id] [description]",
+	Short: "Start a timer on an issue",
+	Long: `Starts tracking time on an issue. A timer running on another issue is stopped
+and logged first. Without arguments a paused timer is resumed; starting the timer's own issue
+again resumes it too, replacing its description when a new one is given.`,
+	Args: cobra.MaximumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		current, err := timer.Load()
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		now := time.Now()
+
+		// Resume a paused timer on the same issue (or when no issue is given)
+		if current != nil && (len(args) == 0 || args[0] == current.IssueID) {
+			note := ""
+			if len(args) > 1 && args[1] != current.Description {
+				current.Description = args[1]
+				note = ", description updated"
+			}
+			if current.Running() && note == "" {
+				fmt.Printf("Timer already running on %s (%s).\n", current.IssueID, youtrack.HumanizeDuration(current.Total(now)))
+				return
+			}
+			wasRunning := current.Running()
+			current.Resume(now)
+			if err := timer.Save(current); err != nil {
+				fmt.Printf("Error: %v\n", err)
+				return
+			}
+			if wasRunning {
+				fmt.Printf("Timer already running on %s (%s)%s.\n", current.IssueID, youtrack.HumanizeDuration(current.Total(now)), note)
+			} else {
+				fmt.Printf("Timer resumed on %s (%s so far)%s.\n", current.IssueID, youtrack.HumanizeDuration(current.Total(now)), note)
+			}
+			return
+		}
+
+		if len(args) == 0 {
+			fmt.Println("Error: no timer to resume, pass an issue ID to start one")
+			return
+		}
+
+		if current != nil {
+			if err := stopTimer(cfg, current, now); err != nil {
+				fmt.Printf("Error stopping timer on %s: %v\n", current.IssueID, err)
+				return
+			}
+		}
+
+		description := ""
+		if len(args) > 1 {
+			description = args[1]
+		}
+		if err := timer.Save(timer.Start(args[0], description, now)); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		fmt.Printf("Timer started on %s.\n", args[0])
+	},
+}
+
+var stopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop the timer and log the tracked time",
+	Long: `Stops the running or paused timer, rounds the tracked time according to the
+timer settings in the config and adds it as a work item.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		current, err := timer.Load()
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		if current == nil {
+			fmt.Println("No timer is running.")
+			return
+		}
+
+		if discard, _ := cmd.Flags().GetBool("discard"); discard {
+			if err := timer.Clear(); err != nil {
+				fmt.Printf("Error: %v\n", err)
+				return
+			}
+			fmt.Printf("Timer on %s discarded.\n", current.IssueID)
+			return
+		}
+
+		if err := stopTimer(cfg, current, time.Now()); err != nil {
+			fmt.Printf("Error stopping timer on %s: %v\n", current.IssueID, err)
+		}
+	},
+}
+
+var pauseCmd = &cobra.Command{
+	Use:   "pause",
+	Short: "Pause the running timer",
+	Long:  `Pauses the running timer; 'youtrack-cli work start' resumes it.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		current, err := timer.Load()
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		if current == nil || !current.Running() {
+			fmt.Println("No timer is running.")
+			return
+		}
+
+		now := time.Now()
+		current.Pause(now)
+		if err := timer.Save(current); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		fmt.Printf("Timer on %s paused at %s.\n", current.IssueID, youtrack.HumanizeDuration(current.Total(now)))
+	},
+}
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the current timer",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		current, err := timer.Load()
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		if current == nil {
+			fmt.Println("No timer is running.")
+			return
+		}
+
+		state := "running"
+		if !current.Running() {
+			state = "paused"
+		}
+		fmt.Printf("%s: %s (%s, started %s)\n", current.IssueID, youtrack.HumanizeDuration(current.Total(time.Now())),
+			state, current.StartedAt.Format("2006-01-02 15:04"))
+		if current.Description != "" {
+			fmt.Printf("Description: %s\n", current.Description)
+		}
+	},
+}
+
+// stopTimer rounds the tracked time, logs it on the timer's issue and clears the timer.
+// Time that rounds to zero is discarded.
+func stopTimer(cfg config.Config, t *timer.Timer, now time.Time) error {
+	unit, mode, err := cfg.Timer.Rounding()
+	if err != nil {
+		return err
+	}
+
+	tracked := t.Total(now)
+	logged := timer.Round(tracked, unit, mode)
+	if logged < time.Minute {
+		fmt.Printf("Tracked %s on %s, nothing to log after rounding.\n", tracked.Round(time.Second), t.IssueID)
+		return timer.Clear()
+	}
+
+	item := youtrack.NewWorkItem{Duration: logged, Description: t.Description, Date: t.StartedAt}
+	err = youtrack.AddWorkItem(cfg, t.IssueID, item)
+	if errors.Is(err, youtrack.ErrQueued) {
+		fmt.Printf("YouTrack is unreachable, queued %s on %s. Run 'youtrack-cli sync' when back online.\n", youtrack.HumanizeDuration(logged), t.IssueID)
+		return timer.Clear()
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Logged %s on %s (tracked %s).\n", youtrack.HumanizeDuration(logged), t.IssueID, tracked.Round(time.Second))
+	return timer.Clear()
+}
+
+func init() {
+	WorkCmd.AddCommand(startCmd) // WorkCmd is defined in cmd/work/root.go
+	WorkCmd.AddCommand(stopCmd)
+	WorkCmd.AddCommand(pauseCmd)
+	WorkCmd.AddCommand(statusCmd)
+
+	stopCmd.Flags().Bool("discard", false, "Stop the timer without logging the tracked time")
+}
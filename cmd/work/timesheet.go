@@ -0,0 +1,66 @@
+package work
+
+import (
+	"fmt"
+	"time"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
+var timesheetCmd = &cobra.Command{
+	Use:   "timesheet",
+	Short: "Show a weekly timesheet of your logged work",
+	Long: `Shows the time you logged during a week as a day-by-issue grid, including issues no longer
+assigned to you. Daily totals are compared against the daily_hours target from the config
+and completed workdays under target are flagged. As with 'work check', today is only checked
+with --include-today and configured days off are skipped.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+		if err := cfg.Calendar.Validate(); err != nil {
+			fmt.Printf("Error in configuration: %v\n", err)
+			return
+		}
+
+		now := time.Now()
+		week, _ := cmd.Flags().GetString("week")
+		monday, err := youtrack.ParseISOWeek(week, now)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		user, err := youtrack.CurrentUser(cfg)
+		if err != nil {
+			fmt.Printf("Error fetching current user: %v\n", err)
+			return
+		}
+
+		items, err := youtrack.FetchWorkItems(cfg, youtrack.WorkItemFilter{
+			Since:  monday,
+			Until:  monday.AddDate(0, 0, 6),
+			Author: user.Login,
+		})
+		if err != nil {
+			fmt.Printf("Error fetching work items: %v\n", err)
+			return
+		}
+
+		ts := youtrack.BuildTimesheet(cfg, items, monday, user.FullName)
+		ts.Today, _ = cmd.Flags().GetBool("include-today")
+		youtrack.PrintTimesheet(ts, now)
+	},
+}
+
+func init() {
+	WorkCmd.AddCommand(timesheetCmd) // WorkCmd is defined in cmd/work/root.go
+
+	timesheetCmd.Flags().String("week", "", "ISO week to show, e.g. 2026-W42 (default: current week)")
+	timesheetCmd.Flags().Bool("include-today", false, "Also flag today when it is under target")
+}
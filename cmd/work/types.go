@@ -0,0 +1,55 @@
+package work
+
+import (
+	"fmt"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/youtrack"
+
+	"github.com/spf13/cobra"
+)
+
+var typesCmd = &cobra.Command{
+	Use:   "types [project]",
+	Short: "List work item types and attributes per project",
+	Long: `Lists the work item types and work item attributes (with their allowed values) defined in the
+time tracking settings of a project, or of all projects when no project is given. These are the
+values accepted by --type and --attr.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading configuration: %v\n", err)
+			return
+		}
+
+		projects, err := youtrack.ListProjects(cfg)
+		if err != nil {
+			fmt.Printf("Error listing projects: %v\n", err)
+			return
+		}
+		if len(args) > 0 {
+			project, err := youtrack.FindProject(projects, args[0])
+			if err != nil {
+				fmt.Printf("Error: %v\n", err)
+				return
+			}
+			projects = []youtrack.Project{project}
+		}
+
+		for i, project := range projects {
+			settings, err := youtrack.FetchProjectTimeTracking(cfg, project.ID)
+			if err != nil {
+				fmt.Printf("Error fetching time tracking settings of %s: %v\n", project.ShortName, err)
+				continue
+			}
+			if i > 0 {
+				fmt.Println()
+			}
+			youtrack.PrintProjectTimeTracking(project, settings)
+		}
+	},
+}
+
+func init() {
+	WorkCmd.AddCommand(typesCmd) // WorkCmd is defined in cmd/work/root.go
+}
@@ -4,16 +4,265 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
 
 // Config defines the structure of the YouTrack CLI configuration.
 type Config struct {
-	URL           string `yaml:"url"`
-	Token         string `yaml:"token"`
-	DefaultSprint string `yaml:"default_sprint,omitempty"`
-	BoardName     string `yaml:"board_name,omitempty"`
+	URL           string   `yaml:"url"`
+	Token         string   `yaml:"token"`
+	DefaultSprint string   `yaml:"default_sprint,omitempty"`
+	BoardName     string   `yaml:"board_name,omitempty"`
+	DailyHours    float64  `yaml:"daily_hours,omitempty"` // Target of logged hours per workday
+	GitAuthor     string   `yaml:"git_author,omitempty"`  // Commit author used by `work suggest`
+	Capacity      Capacity `yaml:"capacity,omitempty"`
+	Timer         Timer    `yaml:"timer,omitempty"`
+	Aging         Aging    `yaml:"aging,omitempty"`
+	Calendar      Calendar `yaml:"calendar,omitempty"`
+}
+
+// Calendar defines the working days of the team.
+type Calendar struct {
+	Workdays      []string `yaml:"workdays,omitempty"`       // Weekday names; default monday to friday
+	ExtraWorkdays []string `yaml:"extra_workdays,omitempty"` // Dates in YYYY-MM-DD format worked in addition, e.g. make-up Saturdays
+	Holidays      []string `yaml:"holidays,omitempty"`       // Dates in YYYY-MM-DD format nobody works
+}
+
+// defaultWorkdays are the working days when the calendar does not list any.
+var defaultWorkdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday"}
+
+// parseWeekday parses a weekday name such as "monday" or "mon".
+func parseWeekday(name string) (time.Weekday, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		full := strings.ToLower(d.String())
+		if name == full || name == full[:3] {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday '%s' (use e.g. monday or mon)", name)
+}
+
+// Validate checks the weekday names and dates of the calendar. Commands relying on the
+// working days call it, so that a typo is reported instead of silently ignored.
+func (c Calendar) Validate() error {
+	for _, name := range c.Workdays {
+		if _, err := parseWeekday(name); err != nil {
+			return fmt.Errorf("calendar workdays: %w", err)
+		}
+	}
+	for _, dates := range [][]string{c.ExtraWorkdays, c.Holidays} {
+		for _, date := range dates {
+			if _, err := time.Parse("2006-01-02", date); err != nil {
+				return fmt.Errorf("invalid calendar date '%s' (use YYYY-MM-DD)", date)
+			}
+		}
+	}
+	return nil
+}
+
+// Aging defines after how many days in the same state an issue is flagged by `report aging`.
+type Aging struct {
+	WarnDays  float64                   `yaml:"warn_days,omitempty"`  // Default 3
+	StaleDays float64                   `yaml:"stale_days,omitempty"` // Default 7
+	States    map[string]AgingThreshold `yaml:"states,omitempty"`     // Per-state overrides keyed by lower-case state name
+}
+
+// AgingThreshold overrides the aging thresholds of a single state.
+type AgingThreshold struct {
+	WarnDays  float64 `yaml:"warn_days,omitempty"`
+	StaleDays float64 `yaml:"stale_days,omitempty"`
+}
+
+// Default aging thresholds in days.
+const (
+	DefaultAgingWarnDays  = 3
+	DefaultAgingStaleDays = 7
+)
+
+// Thresholds returns after how long an issue in the given state is aging and stale.
+func (a Aging) Thresholds(state string) (warn, stale time.Duration) {
+	warnDays, staleDays := a.thresholdDays(state)
+	day := float64(24 * time.Hour)
+	return time.Duration(warnDays * day), time.Duration(staleDays * day)
+}
+
+// thresholdDays returns the thresholds in days of a state, falling back to the global
+// thresholds and then to the defaults.
+func (a Aging) thresholdDays(state string) (warnDays, staleDays float64) {
+	warnDays, staleDays = a.WarnDays, a.StaleDays
+	if warnDays <= 0 {
+		warnDays = DefaultAgingWarnDays
+	}
+	if staleDays <= 0 {
+		staleDays = DefaultAgingStaleDays
+	}
+	if t, ok := a.States[strings.ToLower(state)]; ok {
+		if t.WarnDays > 0 {
+			warnDays = t.WarnDays
+		}
+		if t.StaleDays > 0 {
+			staleDays = t.StaleDays
+		}
+	}
+	return warnDays, staleDays
+}
+
+// normalize lower-cases the state names so that lookups do not depend on their spelling.
+func (a *Aging) normalize() error {
+	if len(a.States) == 0 {
+		return nil
+	}
+	states := make(map[string]AgingThreshold, len(a.States))
+	for name, t := range a.States {
+		key := strings.ToLower(strings.TrimSpace(name))
+		if _, ok := states[key]; ok {
+			return fmt.Errorf("aging state '%s' is configured more than once", name)
+		}
+		states[key] = t
+	}
+	a.States = states
+	return nil
+}
+
+// Validate checks that every state is flagged as aging before it is flagged as stale.
+func (a Aging) Validate() error {
+	if warn, stale := a.thresholdDays(""); warn >= stale {
+		return fmt.Errorf("aging warn_days (%g) must be less than stale_days (%g)", warn, stale)
+	}
+	names := make([]string, 0, len(a.States))
+	for name := range a.States {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if warn, stale := a.thresholdDays(name); warn >= stale {
+			return fmt.Errorf("aging warn_days (%g) must be less than stale_days (%g) for state '%s'", warn, stale, name)
+		}
+	}
+	return nil
+}
+
+// DailyTarget returns the time that should be logged on a workday. It falls back to
+// the default hours_per_day capacity when daily_hours is not set.
+func (c Config) DailyTarget() time.Duration {
+	hours := c.DailyHours
+	if hours <= 0 {
+		hours = c.Capacity.HoursPerDayFor("")
+	}
+	return time.Duration(hours * float64(time.Hour))
+}
+
+// Timer defines how `work stop` rounds the tracked time.
+type Timer struct {
+	RoundTo   string `yaml:"round_to,omitempty"`   // Go duration, e.g. "15m"; empty disables rounding
+	RoundMode string `yaml:"round_mode,omitempty"` // nearest (default), up or down
+}
+
+// Rounding returns the parsed rounding unit and mode of the timer.
+func (t Timer) Rounding() (time.Duration, string, error) {
+	mode := t.RoundMode
+	if mode == "" {
+		mode = "nearest"
+	}
+	if mode != "nearest" && mode != "up" && mode != "down" {
+		return 0, "", fmt.Errorf("invalid timer round_mode '%s' (use nearest, up or down)", t.RoundMode)
+	}
+	if t.RoundTo == "" {
+		return 0, mode, nil
+	}
+	unit, err := time.ParseDuration(t.RoundTo)
+	if err != nil || unit <= 0 {
+		return 0, "", fmt.Errorf("invalid timer round_to '%s' (use e.g. 15m)", t.RoundTo)
+	}
+	return unit, mode, nil
+}
+
+// Capacity defines per-person availability used for sprint planning.
+type Capacity struct {
+	HoursPerDay float64           `yaml:"hours_per_day,omitempty"` // Default for people without their own value
+	People      map[string]Person `yaml:"people,omitempty"`        // Keyed by full name as shown in YouTrack
+}
+
+// Person holds the availability of a single team member.
+type Person struct {
+	HoursPerDay float64  `yaml:"hours_per_day,omitempty"`
+	DaysOff     []string `yaml:"days_off,omitempty"` // Dates in YYYY-MM-DD format
+}
+
+// DefaultHoursPerDay matches the 1d = 6h convention used for estimations.
+const DefaultHoursPerDay = 6
+
+// HoursPerDayFor returns the daily capacity of a person.
+func (c Capacity) HoursPerDayFor(name string) float64 {
+	if p, ok := c.People[name]; ok && p.HoursPerDay > 0 {
+		return p.HoursPerDay
+	}
+	if c.HoursPerDay > 0 {
+		return c.HoursPerDay
+	}
+	return DefaultHoursPerDay
+}
+
+// IsDayOff reports whether the person has the given day off.
+func (c Capacity) IsDayOff(name string, day time.Time) bool {
+	date := day.Format("2006-01-02")
+	for _, off := range c.People[name].DaysOff {
+		if off == date {
+			return true
+		}
+	}
+	return false
+}
+
+// IsWorkday reports whether day is a working day of the calendar: one of the configured
+// workdays (Monday to Friday by default) that is not a holiday, or an extra workday.
+// Invalid weekday names are ignored; see Calendar.Validate.
+func (c Config) IsWorkday(day time.Time) bool {
+	date := day.Format("2006-01-02")
+	for _, extra := range c.Calendar.ExtraWorkdays {
+		if extra == date {
+			return true
+		}
+	}
+	for _, holiday := range c.Calendar.Holidays {
+		if holiday == date {
+			return false
+		}
+	}
+
+	workdays := make(map[time.Weekday]bool)
+	for _, name := range c.Calendar.Workdays {
+		if d, err := parseWeekday(name); err == nil {
+			workdays[d] = true
+		}
+	}
+	if len(workdays) == 0 {
+		for _, name := range defaultWorkdays {
+			d, _ := parseWeekday(name)
+			workdays[d] = true
+		}
+	}
+	return workdays[day.Weekday()]
+}
+
+// StateDir returns the directory holding local state such as the running timer,
+// creating it if needed.
+func StateDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	dir := filepath.Join(home, ".youtrack-cli")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create state directory %s: %w", dir, err)
+	}
+	return dir, nil
 }
 
 // configFilePath returns the absolute path to the configuration file.
@@ -25,7 +274,8 @@ func configFilePath() (string, error) {
 	return filepath.Join(home, ".youtrack-cli.yaml"), nil
 }
 
-// Load loads the configuration from the file.
+// Load loads the configuration from the file. Values only some commands rely on, such as
+// the aging thresholds, are validated by those commands.
 func Load() (Config, error) {
 	var cfg Config
 	path, err := configFilePath()
@@ -44,6 +294,9 @@ func Load() (Config, error) {
 	if err := yaml.Unmarshal(data, &cfg); err != nil {
 		return cfg, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
+	if err := cfg.Aging.normalize(); err != nil {
+		return cfg, fmt.Errorf("invalid config: %w", err)
+	}
 	return cfg, nil
 }
 
@@ -86,6 +339,56 @@ func SetValue(key, value string) error {
 		cfg.DefaultSprint = value
 	case "board":
 		cfg.BoardName = value
+	case "hours_per_day":
+		hours, err := strconv.ParseFloat(value, 64)
+		if err != nil || hours <= 0 {
+			return fmt.Errorf("invalid hours_per_day: %s", value)
+		}
+		cfg.Capacity.HoursPerDay = hours
+	case "daily_hours":
+		hours, err := strconv.ParseFloat(value, 64)
+		if err != nil || hours <= 0 {
+			return fmt.Errorf("invalid daily_hours: %s", value)
+		}
+		cfg.DailyHours = hours
+	case "aging_warn_days", "aging_stale_days":
+		days, err := strconv.ParseFloat(value, 64)
+		if err != nil || days <= 0 {
+			return fmt.Errorf("invalid %s: %s", key, value)
+		}
+		if key == "aging_warn_days" {
+			cfg.Aging.WarnDays = days
+		} else {
+			cfg.Aging.StaleDays = days
+		}
+		if err := cfg.Aging.Validate(); err != nil {
+			return err
+		}
+	case "workdays":
+		var workdays []string
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				workdays = append(workdays, name)
+			}
+		}
+		calendar := cfg.Calendar
+		calendar.Workdays = workdays
+		if err := calendar.Validate(); err != nil {
+			return err
+		}
+		cfg.Calendar = calendar
+	case "git_author":
+		cfg.GitAuthor = value
+	case "timer_round_to":
+		cfg.Timer.RoundTo = value
+		if _, _, err := cfg.Timer.Rounding(); err != nil {
+			return err
+		}
+	case "timer_round_mode":
+		cfg.Timer.RoundMode = value
+		if _, _, err := cfg.Timer.Rounding(); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("unknown config key: %s", key)
 	}
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAgingThresholds(t *testing.T) {
+	aging := Aging{WarnDays: 2, States: map[string]AgingThreshold{
+		"In Review": {StaleDays: 4},
+		"Blocked":   {WarnDays: 1, StaleDays: 2},
+	}}
+	if err := aging.normalize(); err != nil {
+		t.Fatalf("normalize: %v", err)
+	}
+
+	day := 24 * time.Hour
+	tests := []struct {
+		state       string
+		warn, stale time.Duration
+	}{
+		{"In Progress", 2 * day, 7 * day}, // Global warn_days, default stale_days
+		{"in review", 2 * day, 4 * day},
+		{"IN REVIEW", 2 * day, 4 * day},
+		{"Blocked", 1 * day, 2 * day},
+	}
+	for _, tt := range tests {
+		if warn, stale := aging.Thresholds(tt.state); warn != tt.warn || stale != tt.stale {
+			t.Errorf("Thresholds(%q) = %v, %v, want %v, %v", tt.state, warn, stale, tt.warn, tt.stale)
+		}
+	}
+}
+
+func TestAgingNormalizeDuplicate(t *testing.T) {
+	aging := Aging{States: map[string]AgingThreshold{"Blocked": {WarnDays: 1}, "blocked": {WarnDays: 2}}}
+	if err := aging.normalize(); err == nil {
+		t.Error("normalize accepted a state configured twice")
+	}
+}
+
+func TestAgingValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		aging   Aging
+		wantErr bool
+	}{
+		{"defaults", Aging{}, false},
+		{"global", Aging{WarnDays: 1, StaleDays: 2}, false},
+		{"equal", Aging{WarnDays: 5, StaleDays: 5}, true},
+		{"warn above default stale", Aging{WarnDays: 8}, true},
+		{"state inherits global stale", Aging{States: map[string]AgingThreshold{"blocked": {WarnDays: 10}}}, true},
+		{"state override", Aging{States: map[string]AgingThreshold{"blocked": {WarnDays: 10, StaleDays: 20}}}, false},
+	}
+	for _, tt := range tests {
+		if err := tt.aging.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%s) = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestIsWorkday(t *testing.T) {
+	date := func(d int) time.Time { return time.Date(2026, 10, d, 9, 0, 0, 0, time.Local) } // October 5th is a Monday
+	defaults := Config{}
+	custom := Config{Calendar: Calendar{
+		Workdays:      []string{"Sun", "monday", "tue", "wed", "thu"},
+		Holidays:      []string{"2026-10-06"},
+		ExtraWorkdays: []string{"2026-10-10"},
+	}}
+	tests := []struct {
+		name string
+		cfg  Config
+		day  time.Time
+		want bool
+	}{
+		{"default monday", defaults, date(5), true},
+		{"default friday", defaults, date(9), true},
+		{"default saturday", defaults, date(10), false},
+		{"default sunday", defaults, date(11), false},
+		{"custom sunday", custom, date(11), true},
+		{"custom friday", custom, date(9), false},
+		{"holiday", custom, date(6), false},
+		{"extra workday", custom, date(10), true},
+		{"invalid names fall back to defaults", Config{Calendar: Calendar{Workdays: []string{"funday"}}}, date(9), true},
+	}
+	for _, tt := range tests {
+		if got := tt.cfg.IsWorkday(tt.day); got != tt.want {
+			t.Errorf("IsWorkday(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCalendarValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar Calendar
+		wantErr  bool
+	}{
+		{"empty", Calendar{}, false},
+		{"short and long names", Calendar{Workdays: []string{"mon", "Tuesday", " wed "}}, false},
+		{"unknown weekday", Calendar{Workdays: []string{"mon", "funday"}}, true},
+		{"dates", Calendar{Holidays: []string{"2026-10-01"}, ExtraWorkdays: []string{"2026-10-10"}}, false},
+		{"bad holiday", Calendar{Holidays: []string{"2026-13-01"}}, true},
+		{"bad extra workday", Calendar{ExtraWorkdays: []string{"10/10/2026"}}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.calendar.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%s) = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package gitlog
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// messageIssuePattern matches issue IDs such as "DP-123" in commit messages. Only upper
+// case project keys are accepted so that words like "utf-8" are not taken for issues;
+// "UTF-8" or "SHA-256" still match and are dropped unless the key is a known project.
+var messageIssuePattern = regexp.MustCompile(`\b[A-Z][A-Z0-9_]*-\d+\b`)
+
+// branchIssuePattern matches issue IDs in branch names, where they are often lower case.
+var branchIssuePattern = regexp.MustCompile(`(?i)\b[a-z][a-z0-9_]*-\d+\b`)
+
+// Commit is a commit read from the local repository.
+type Commit struct {
+	Hash    string
+	Time    time.Time
+	Subject string
+	Body    string
+	Ref     string // Ref the commit was reached from, e.g. refs/heads/feature/DP-123-login
+}
+
+// IssueIDs returns the issue IDs mentioned in the commit message or, if there are none,
+// in the name of the branch it was reached from. Only IDs whose key is one of projects
+// (upper case short names) are returned.
+func (c Commit) IssueIDs(projects map[string]bool) []string {
+	ids := knownIssueIDs(messageIssuePattern.FindAllString(c.Subject+"\n"+c.Body, -1), projects)
+	if len(ids) > 0 {
+		return ids
+	}
+	branch := strings.TrimPrefix(strings.TrimPrefix(c.Ref, "refs/heads/"), "refs/remotes/")
+	return knownIssueIDs(branchIssuePattern.FindAllString(branch, -1), projects)
+}
+
+// knownIssueIDs upper-cases ids, keeps those of known projects and removes duplicates,
+// keeping the first occurrence.
+func knownIssueIDs(ids []string, projects map[string]bool) []string {
+	var result []string
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		id = strings.ToUpper(id)
+		key, _, _ := strings.Cut(id, "-")
+		if projects[key] && !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// DefaultAuthor returns the user.email configured for git in the current repository.
+func DefaultAuthor() (string, error) {
+	out, err := exec.Command("git", "config", "user.email").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to read git user.email: %w", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Commits returns the commits of all local branches made by author since the given
+// time, oldest first. Author is matched by git as a pattern on name and email.
+func Commits(author string, since time.Time) ([]Commit, error) {
+	args := []string{"log", "--branches", "--source", "--no-merges",
+		"--since=" + since.Format(time.RFC3339), "--format=%H%x1f%at%x1f%S%x1f%s%x1f%b%x1e"}
+	if author != "" {
+		args = append(args, "--author="+author)
+	}
+
+	cmd := exec.Command("git", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git log failed: %v %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var commits []Commit
+	for _, record := range strings.Split(string(out), "\x1e") {
+		fields := strings.SplitN(strings.TrimSpace(record), "\x1f", 5)
+		if len(fields) < 5 {
+			continue
+		}
+		seconds, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		commits = append(commits, Commit{
+			Hash:    fields[0],
+			Time:    time.Unix(seconds, 0),
+			Ref:     fields[2],
+			Subject: fields[3],
+			Body:    fields[4],
+		})
+	}
+
+	sort.SliceStable(commits, func(i, j int) bool { return commits[i].Time.Before(commits[j].Time) })
+	return commits, nil
+}
+
+// Suggestion is proposed work on an issue on a single day, derived from commits.
+type Suggestion struct {
+	IssueID  string
+	Day      time.Time // Local midnight
+	Duration time.Duration
+	Subjects []string // Subjects of the commits the time comes from
+}
+
+// Suggest estimates the time behind each commit as the time since the previous commit
+// on the same day, or firstCommit when that gap is longer than maxGap, and sums it per
+// issue and day. Time of commits mentioning several issues is split between them;
+// commits without an issue ID of one of projects are skipped. Suggestions are ordered by
+// day, then issue.
+func Suggest(commits []Commit, projects map[string]bool, maxGap, firstCommit time.Duration) []Suggestion {
+	byKey := make(map[string]*Suggestion)
+	var suggestions []*Suggestion
+
+	var previous time.Time
+	for _, c := range commits {
+		d := firstCommit
+		if !previous.IsZero() && sameDay(previous, c.Time) && c.Time.Sub(previous) <= maxGap {
+			d = c.Time.Sub(previous)
+		}
+		previous = c.Time
+
+		ids := c.IssueIDs(projects)
+		if len(ids) == 0 {
+			continue
+		}
+		day := time.Date(c.Time.Year(), c.Time.Month(), c.Time.Day(), 0, 0, 0, 0, c.Time.Location())
+		for _, id := range ids {
+			key := id + "|" + day.Format("2006-01-02")
+			s, ok := byKey[key]
+			if !ok {
+				s = &Suggestion{IssueID: id, Day: day}
+				byKey[key] = s
+				suggestions = append(suggestions, s)
+			}
+			s.Duration += d / time.Duration(len(ids))
+			s.Subjects = append(s.Subjects, c.Subject)
+		}
+	}
+
+	result := make([]Suggestion, 0, len(suggestions))
+	for _, s := range suggestions {
+		result = append(result, *s)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if !result[i].Day.Equal(result[j].Day) {
+			return result[i].Day.Before(result[j].Day)
+		}
+		return result[i].IssueID < result[j].IssueID
+	})
+	return result
+}
+
+// sameDay reports whether a and b fall on the same local calendar day.
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
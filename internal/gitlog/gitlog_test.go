@@ -0,0 +1,52 @@
+package gitlog
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCommitIssueIDs(t *testing.T) {
+	projects := map[string]bool{"DP": true, "CRM": true}
+	tests := []struct {
+		name   string
+		commit Commit
+		want   []string
+	}{
+		{"message", Commit{Subject: "DP-12 fix login", Body: "Refs CRM-3, DP-12"}, []string{"DP-12", "CRM-3"}},
+		{"unknown keys", Commit{Subject: "Read files as UTF-8, hash with SHA-256 (ISO-8601 dates)"}, nil},
+		{"unknown keys fall back to branch", Commit{Subject: "Use UTF-8", Ref: "refs/heads/feature/dp-7-encoding"}, []string{"DP-7"}},
+		{"message wins over branch", Commit{Subject: "CRM-1 tweak", Ref: "refs/heads/dp-7"}, []string{"CRM-1"}},
+		{"lower case message ignored", Commit{Subject: "dp-5 wip"}, nil},
+	}
+	for _, tt := range tests {
+		if got := tt.commit.IssueIDs(projects); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: IssueIDs() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	at := func(hour, minute int) time.Time { return time.Date(2026, 10, 14, hour, minute, 0, 0, time.Local) }
+	commits := []Commit{
+		{Time: at(9, 0), Subject: "DP-1 start"},
+		{Time: at(10, 0), Subject: "DP-1 more"},
+		{Time: at(10, 30), Subject: "DP-1 DP-2 shared"},
+		{Time: at(15, 0), Subject: "DP-2 after lunch"},
+		{Time: at(15, 20), Subject: "bump UTF-8 handling"},
+	}
+	got := Suggest(commits, map[string]bool{"DP": true}, 2*time.Hour, 30*time.Minute)
+
+	want := map[string]time.Duration{
+		"DP-1": 30*time.Minute + time.Hour + 15*time.Minute,
+		"DP-2": 15*time.Minute + 30*time.Minute,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Suggest() returned %d suggestions, want %d: %+v", len(got), len(want), got)
+	}
+	for _, s := range got {
+		if s.Duration != want[s.IssueID] {
+			t.Errorf("%s: duration %v, want %v", s.IssueID, s.Duration, want[s.IssueID])
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package term
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// DefaultWidth is used when the terminal width cannot be determined.
+const DefaultWidth = 120
+
+// Size returns the rows and columns of the controlling terminal.
+// It honours $LINES/$COLUMNS and otherwise asks the terminal.
+func Size() (rows, cols int, ok bool) {
+	rows, _ = strconv.Atoi(os.Getenv("LINES"))
+	cols, _ = strconv.Atoi(os.Getenv("COLUMNS"))
+	if rows > 0 && cols > 0 {
+		return rows, cols, true
+	}
+	return ttySize()
+}
+
+// Width returns the terminal width in cells, or DefaultWidth.
+func Width() int {
+	if _, cols, ok := Size(); ok {
+		return cols
+	}
+	return DefaultWidth
+}
+
+// RuneWidth returns the number of cells r occupies (2 for East Asian wide characters).
+func RuneWidth(r rune) int {
+	switch {
+	case r < 0x1100:
+		return 1
+	case r <= 0x115F, // Hangul Jamo
+		r >= 0x2E80 && r <= 0xA4CF && r != 0x303F, // CJK radicals … Yi
+		r >= 0xAC00 && r <= 0xD7A3,                // Hangul syllables
+		r >= 0xF900 && r <= 0xFAFF,                // CJK compatibility ideographs
+		r >= 0xFE30 && r <= 0xFE4F,                // CJK compatibility forms
+		r >= 0xFF00 && r <= 0xFF60,                // Fullwidth forms
+		r >= 0xFFE0 && r <= 0xFFE6,
+		r >= 0x1F300 && r <= 0x1F64F, // Emoji
+		r >= 0x20000 && r <= 0x3FFFD:
+		return 2
+	}
+	return 1
+}
+
+// StringWidth returns the number of cells s occupies.
+func StringWidth(s string) int {
+	w := 0
+	for _, r := range s {
+		w += RuneWidth(r)
+	}
+	return w
+}
+
+// Truncate shortens s to at most width cells, ending with "…" when cut.
+func Truncate(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if StringWidth(s) <= width {
+		return s
+	}
+
+	var sb strings.Builder
+	w := 0
+	for _, r := range s {
+		rw := RuneWidth(r)
+		if w+rw > width-1 {
+			break
+		}
+		sb.WriteRune(r)
+		w += rw
+	}
+	sb.WriteRune('…')
+	return sb.String()
+}
+
+// Pad truncates or right-pads s with spaces to exactly width cells.
+func Pad(s string, width int) string {
+	s = Truncate(s, width)
+	if w := StringWidth(s); w < width {
+		s += strings.Repeat(" ", width-w)
+	}
+	return s
+}
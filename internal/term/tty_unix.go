@@ -0,0 +1,58 @@
+//go:build !windows
+
+package term
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// ttySize asks stty for the size of the controlling terminal.
+func ttySize() (rows, cols int, ok bool) {
+	out, err := stty("size")
+	if err != nil {
+		return 0, 0, false
+	}
+	parts := strings.Fields(out)
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	rows, err1 := strconv.Atoi(parts[0])
+	cols, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil || rows <= 0 || cols <= 0 {
+		return 0, 0, false
+	}
+	return rows, cols, true
+}
+
+// MakeRaw switches the terminal to unbuffered input without echo or signal keys
+// and returns a function that restores the previous settings.
+func MakeRaw() (func(), error) {
+	saved, err := stty("-g")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read terminal settings: %w", err)
+	}
+	if _, err := stty("-icanon", "-echo", "-isig", "-ixon", "min", "1", "time", "0"); err != nil {
+		return nil, fmt.Errorf("failed to switch terminal to raw mode: %w", err)
+	}
+	return func() {
+		stty(strings.TrimSpace(saved))
+	}, nil
+}
+
+// stty runs stty against the controlling terminal.
+func stty(args ...string) (string, error) {
+	tty, err := os.Open("/dev/tty")
+	if err != nil {
+		return "", err
+	}
+	defer tty.Close()
+
+	c := exec.Command("stty", args...)
+	c.Stdin = tty
+	out, err := c.Output()
+	return string(out), err
+}
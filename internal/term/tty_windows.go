@@ -0,0 +1,19 @@
+//go:build windows
+
+package term
+
+import "errors"
+
+// ErrUnsupported is returned by MakeRaw on Windows, where the terminal is driven through stty
+// on Unix systems only.
+var ErrUnsupported = errors.New("raw terminal mode is not supported on Windows")
+
+// ttySize cannot query the console on Windows; callers fall back to $LINES/$COLUMNS or defaults.
+func ttySize() (rows, cols int, ok bool) {
+	return 0, 0, false
+}
+
+// MakeRaw always fails on Windows, so full-screen commands refuse to start.
+func MakeRaw() (func(), error) {
+	return nil, ErrUnsupported
+}
@@ -0,0 +1,132 @@
+package timer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+// Timer is the running (or paused) work timer persisted between shell sessions.
+type Timer struct {
+	IssueID     string        `json:"issue_id"`
+	Description string        `json:"description,omitempty"`
+	StartedAt   time.Time     `json:"started_at"`           // When the timer was first started
+	ResumedAt   time.Time     `json:"resumed_at,omitempty"` // Start of the running segment, zero while paused
+	Elapsed     time.Duration `json:"elapsed"`              // Time tracked before the running segment
+}
+
+// Start creates a running timer for an issue.
+func Start(issueID, description string, now time.Time) *Timer {
+	return &Timer{IssueID: issueID, Description: description, StartedAt: now, ResumedAt: now}
+}
+
+// Running reports whether the timer is counting.
+func (t *Timer) Running() bool {
+	return !t.ResumedAt.IsZero()
+}
+
+// Total returns the tracked time up to now.
+func (t *Timer) Total(now time.Time) time.Duration {
+	total := t.Elapsed
+	if t.Running() {
+		total += now.Sub(t.ResumedAt)
+	}
+	return total
+}
+
+// Pause stops counting while keeping the tracked time.
+func (t *Timer) Pause(now time.Time) {
+	if t.Running() {
+		t.Elapsed += now.Sub(t.ResumedAt)
+		t.ResumedAt = time.Time{}
+	}
+}
+
+// Resume continues counting after a pause.
+func (t *Timer) Resume(now time.Time) {
+	if !t.Running() {
+		t.ResumedAt = now
+	}
+}
+
+// Round rounds d to a multiple of unit; mode is nearest, up or down. A zero unit only drops
+// the seconds, since YouTrack records work in whole minutes.
+func Round(d, unit time.Duration, mode string) time.Duration {
+	if unit <= 0 {
+		return d.Truncate(time.Minute)
+	}
+	switch mode {
+	case "up":
+		if r := d % unit; r != 0 {
+			return d - r + unit
+		}
+		return d
+	case "down":
+		return d.Truncate(unit)
+	default:
+		return d.Round(unit)
+	}
+}
+
+// statePath returns the path of the timer state file.
+func statePath() (string, error) {
+	dir, err := config.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "timer.json"), nil
+}
+
+// Load reads the persisted timer; it returns nil when no timer is active.
+func Load() (*Timer, error) {
+	path, err := statePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read timer state: %w", err)
+	}
+
+	var t Timer
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("failed to parse timer state %s: %w", path, err)
+	}
+	return &t, nil
+}
+
+// Save persists the timer.
+func Save(t *Timer) error {
+	path, err := statePath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal timer state: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write timer state to %s: %w", path, err)
+	}
+	return nil
+}
+
+// Clear removes the persisted timer.
+func Clear() error {
+	path, err := statePath()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove timer state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package timer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimerPauseResume(t *testing.T) {
+	t0 := time.Date(2026, 10, 14, 9, 0, 0, 0, time.Local)
+	tm := Start("DP-1", "review", t0)
+	if !tm.Running() || tm.Total(t0.Add(10*time.Minute)) != 10*time.Minute {
+		t.Fatalf("started timer: running %v, total %v", tm.Running(), tm.Total(t0.Add(10*time.Minute)))
+	}
+
+	tm.Pause(t0.Add(20 * time.Minute))
+	tm.Pause(t0.Add(25 * time.Minute)) // Pausing twice keeps the first pause
+	if tm.Running() || tm.Total(t0.Add(time.Hour)) != 20*time.Minute {
+		t.Errorf("paused timer: running %v, total %v, want 20m", tm.Running(), tm.Total(t0.Add(time.Hour)))
+	}
+
+	tm.Resume(t0.Add(time.Hour))
+	tm.Resume(t0.Add(90 * time.Minute)) // Resuming a running timer changes nothing
+	if got := tm.Total(t0.Add(100 * time.Minute)); got != 60*time.Minute {
+		t.Errorf("resumed timer total = %v, want 1h", got)
+	}
+	if !tm.StartedAt.Equal(t0) {
+		t.Errorf("StartedAt = %v, want %v", tm.StartedAt, t0)
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		d, unit time.Duration
+		mode    string
+		want    time.Duration
+	}{
+		{52*time.Minute + 30*time.Second, 0, "nearest", 52 * time.Minute}, // Only seconds are dropped
+		{52 * time.Minute, 15 * time.Minute, "nearest", 45 * time.Minute},
+		{53 * time.Minute, 15 * time.Minute, "nearest", time.Hour},
+		{46 * time.Minute, 15 * time.Minute, "up", time.Hour},
+		{45 * time.Minute, 15 * time.Minute, "up", 45 * time.Minute},
+		{59 * time.Minute, 15 * time.Minute, "down", 45 * time.Minute},
+		{7 * time.Minute, 15 * time.Minute, "down", 0},
+		{7*time.Minute + 30*time.Second, 15 * time.Minute, "", 15 * time.Minute}, // Empty mode rounds to nearest
+	}
+	for _, tt := range tests {
+		if got := Round(tt.d, tt.unit, tt.mode); got != tt.want {
+			t.Errorf("Round(%v, %v, %q) = %v, want %v", tt.d, tt.unit, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestPersistence(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if tm, err := Load(); err != nil || tm != nil {
+		t.Fatalf("Load without state = %v, %v, want nil, nil", tm, err)
+	}
+
+	t0 := time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC)
+	want := Start("DP-1", "review", t0)
+	want.Pause(t0.Add(30 * time.Minute))
+	if err := Save(want); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	got, err := Load()
+	if err != nil || got == nil {
+		t.Fatalf("Load = %v, %v", got, err)
+	}
+	if got.IssueID != want.IssueID || got.Description != want.Description || !got.StartedAt.Equal(want.StartedAt) ||
+		got.Running() || got.Elapsed != 30*time.Minute {
+		t.Errorf("Load = %+v, want %+v", got, want)
+	}
+
+	if err := Clear(); err != nil {
+		t.Fatalf("Clear: %v", err)
+	}
+	if tm, err := Load(); err != nil || tm != nil {
+		t.Errorf("Load after Clear = %v, %v, want nil, nil", tm, err)
+	}
+	if err := Clear(); err != nil {
+		t.Errorf("Clear without state: %v", err)
+	}
+}
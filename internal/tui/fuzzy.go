@@ -0,0 +1,36 @@
+package tui
+
+import (
+	"strings"
+	"unicode"
+	"youtrack-cli/internal/youtrack"
+)
+
+// fuzzyMatch reports whether all characters of pattern appear in text in order,
+// ignoring case and spaces in the pattern. An empty pattern matches everything.
+func fuzzyMatch(pattern, text string) bool {
+	text = strings.ToLower(text)
+	pos := 0
+	for _, r := range strings.ToLower(pattern) {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		idx := strings.IndexRune(text[pos:], r)
+		if idx < 0 {
+			return false
+		}
+		pos += idx + len(string(r))
+	}
+	return true
+}
+
+// issueHaystack is the text the filter is matched against.
+func issueHaystack(iss youtrack.Issue) string {
+	return strings.Join([]string{
+		iss.ID,
+		iss.Summary,
+		youtrack.IssueField(iss, "State"),
+		youtrack.IssueField(iss, "Type"),
+		youtrack.IssueAssignee(iss),
+	}, " ")
+}
@@ -0,0 +1,9 @@
+//go:build windows
+
+package tui
+
+import "os"
+
+// notifyResize is a no-op on Windows. The TUI does not start there (term.MakeRaw fails);
+// this only keeps the package building for the other commands.
+func notifyResize(c chan<- os.Signal) {}
@@ -0,0 +1,550 @@
+package tui
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/term"
+	"youtrack-cli/internal/youtrack"
+)
+
+// Options holds the filters the issue list starts with; they mirror the list command flags.
+type Options struct {
+	Sprint   string
+	Assignee string
+	Type     string
+}
+
+// detailHeight is the number of lines reserved for the detail pane.
+const detailHeight = 9
+
+// escTimeout is how long to wait for the rest of an escape sequence after ESC.
+const escTimeout = 50 * time.Millisecond
+
+// ANSI escape sequences
+const (
+	altScreenOn  = "\x1b[?1049h"
+	altScreenOff = "\x1b[?1049l"
+	cursorHide   = "\x1b[?25l"
+	cursorShow   = "\x1b[?25h"
+	clearScreen  = "\x1b[H\x1b[2J"
+	reverse      = "\x1b[7m"
+	bold         = "\x1b[1m"
+	dim          = "\x1b[2m"
+	reset        = "\x1b[0m"
+)
+
+// Key names produced by readKey for non-printable input.
+const (
+	keyUp       = "up"
+	keyDown     = "down"
+	keyPageUp   = "pgup"
+	keyPageDown = "pgdn"
+	keyEnter    = "enter"
+	keyEsc      = "esc"
+	keyBack     = "backspace"
+	keyCtrlC    = "ctrl-c"
+	keyResize   = "resize" // The terminal was resized; not a key press
+)
+
+type app struct {
+	cfg    config.Config
+	opts   Options
+	sprint string // Resolved sprint name, "" when listing without sprint filter
+
+	issues   []youtrack.Issue
+	filter   string
+	filtered []int // Indexes into issues matching filter
+	cursor   int   // Index into filtered
+	offset   int   // First visible row of filtered
+
+	status string
+	rows   int
+	cols   int
+
+	keys   chan byte
+	resize chan os.Signal
+	out    *bufio.Writer
+}
+
+// Run starts the full-screen issue browser and blocks until the user quits.
+func Run(cfg config.Config, opts Options) error {
+	restore, err := term.MakeRaw()
+	if err != nil {
+		return err
+	}
+	defer restore()
+
+	a := &app{cfg: cfg, opts: opts, keys: make(chan byte, 64), resize: make(chan os.Signal, 1), out: bufio.NewWriter(os.Stdout)}
+	go a.readInput()
+
+	// The size is only read again on SIGWINCH; asking stty on every redraw is too slow
+	a.updateSize()
+	notifyResize(a.resize)
+	defer signal.Stop(a.resize)
+
+	fmt.Fprint(a.out, altScreenOn, cursorHide)
+	a.out.Flush()
+	defer func() {
+		fmt.Fprint(a.out, cursorShow, altScreenOff)
+		a.out.Flush()
+	}()
+
+	a.reload()
+	for {
+		a.render()
+		key := a.readKey()
+		if !a.handleKey(key) {
+			return nil
+		}
+	}
+}
+
+// readInput forwards stdin bytes to the key channel.
+func (a *app) readInput() {
+	buf := make([]byte, 64)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			close(a.keys)
+			return
+		}
+		for _, b := range buf[:n] {
+			a.keys <- b
+		}
+	}
+}
+
+// readKey waits for the next key press and returns its name or the typed character.
+// A terminal resize is reported as keyResize after the new size has been read.
+func (a *app) readKey() string {
+	var b byte
+	var ok bool
+	select {
+	case b, ok = <-a.keys:
+	case <-a.resize:
+		a.updateSize()
+		return keyResize
+	}
+	if !ok {
+		return keyCtrlC
+	}
+
+	switch b {
+	case 3:
+		return keyCtrlC
+	case '\r', '\n':
+		return keyEnter
+	case 127, 8:
+		return keyBack
+	case 27:
+		return a.readEscape()
+	}
+
+	// Collect the remaining bytes of a multi-byte UTF-8 character
+	seq := []byte{b}
+	for need := utf8Continuations(b); need > 0; need-- {
+		if c, ok := <-a.keys; ok {
+			seq = append(seq, c)
+		}
+	}
+	return string(seq)
+}
+
+// readEscape decodes arrow and page keys; a lone ESC is reported as keyEsc.
+func (a *app) readEscape() string {
+	next := func() (byte, bool) {
+		select {
+		case c, ok := <-a.keys:
+			return c, ok
+		case <-time.After(escTimeout):
+			return 0, false
+		}
+	}
+
+	c, ok := next()
+	if !ok || (c != '[' && c != 'O') {
+		return keyEsc
+	}
+	c, ok = next()
+	if !ok {
+		return keyEsc
+	}
+	switch c {
+	case 'A':
+		return keyUp
+	case 'B':
+		return keyDown
+	case '5', '6':
+		next() // trailing '~'
+		if c == '5' {
+			return keyPageUp
+		}
+		return keyPageDown
+	}
+	return keyEsc
+}
+
+// utf8Continuations returns how many continuation bytes follow a UTF-8 lead byte.
+func utf8Continuations(b byte) int {
+	switch {
+	case b&0xE0 == 0xC0:
+		return 1
+	case b&0xF0 == 0xE0:
+		return 2
+	case b&0xF8 == 0xF0:
+		return 3
+	}
+	return 0
+}
+
+// handleKey applies a key press; it returns false when the app should exit.
+func (a *app) handleKey(key string) bool {
+	switch key {
+	case "q", keyCtrlC:
+		return false
+	case keyUp, "k":
+		a.move(-1)
+	case keyDown, "j":
+		a.move(1)
+	case keyPageUp:
+		a.move(-a.listHeight())
+	case keyPageDown:
+		a.move(a.listHeight())
+	case "g":
+		a.move(-len(a.filtered))
+	case "G":
+		a.move(len(a.filtered))
+	case "/":
+		a.editFilter()
+	case keyEsc:
+		a.setFilter("")
+	case "r":
+		a.reload()
+	case "S":
+		a.switchSprint()
+	case "s":
+		a.changeState()
+	case "w":
+		a.logWork()
+	case "c":
+		a.comment()
+	}
+	return true
+}
+
+// reload fetches issues using the same sprint resolution and query as the list command.
+func (a *app) reload() {
+	a.status = "Loading issues…"
+	a.render()
+
+	note := ""
+	sprint, boardName, err := youtrack.DetermineSprint(a.cfg, a.opts.Sprint)
+	if err != nil {
+		note = fmt.Sprintf("Could not determine sprint: %v. Listing issues without sprint filter.", err)
+		sprint = ""
+	}
+	a.sprint = sprint
+
+	query, err := youtrack.BuildQuery(sprint, a.opts.Assignee, a.opts.Type, boardName)
+	if err != nil {
+		note = fmt.Sprintf("%v. Listing issues without sprint filter.", err)
+		a.sprint = ""
+	}
+	issues, warnings, err := youtrack.FetchIssues(a.cfg, query)
+	if err != nil {
+		a.status = fmt.Sprintf("Error fetching issues: %v", err)
+		return
+	}
+
+	a.issues = issues
+	a.setFilter(a.filter)
+	a.status = fmt.Sprintf("Loaded %d issue(s).", len(issues))
+	if len(warnings) > 0 {
+		a.status = fmt.Sprintf("Loaded %d issue(s). Warning: %s", len(issues), warnings[0])
+		if len(warnings) > 1 {
+			a.status += fmt.Sprintf(" (and %d more)", len(warnings)-1)
+		}
+	}
+	if note != "" {
+		a.status = note
+	}
+}
+
+// setFilter applies a fuzzy filter and keeps the cursor in range.
+func (a *app) setFilter(filter string) {
+	a.filter = filter
+	a.filtered = a.filtered[:0]
+	for i, iss := range a.issues {
+		if fuzzyMatch(filter, issueHaystack(iss)) {
+			a.filtered = append(a.filtered, i)
+		}
+	}
+	a.move(0)
+}
+
+// move shifts the cursor by delta rows and scrolls the list to keep it visible.
+func (a *app) move(delta int) {
+	a.cursor += delta
+	if a.cursor >= len(a.filtered) {
+		a.cursor = len(a.filtered) - 1
+	}
+	if a.cursor < 0 {
+		a.cursor = 0
+	}
+
+	height := a.listHeight()
+	if a.cursor < a.offset {
+		a.offset = a.cursor
+	}
+	if a.cursor >= a.offset+height {
+		a.offset = a.cursor - height + 1
+	}
+}
+
+// selected returns the issue under the cursor.
+func (a *app) selected() (youtrack.Issue, bool) {
+	if len(a.filtered) == 0 {
+		return youtrack.Issue{}, false
+	}
+	return a.issues[a.filtered[a.cursor]], true
+}
+
+// editFilter lets the user type a fuzzy filter, updating the list as they type.
+func (a *app) editFilter() {
+	previous := a.filter
+	value, ok := a.prompt("Filter: ", a.filter, a.setFilter)
+	if !ok {
+		a.setFilter(previous)
+		return
+	}
+	a.setFilter(value)
+}
+
+// switchSprint asks for a sprint name or keyword and reloads the list.
+func (a *app) switchSprint() {
+	value, ok := a.prompt("Sprint (name, current, next, previous; empty for default): ", a.opts.Sprint, nil)
+	if !ok {
+		return
+	}
+	a.opts.Sprint = strings.TrimSpace(value)
+	a.reload()
+}
+
+// changeState asks for a new State value for the selected issue.
+func (a *app) changeState() {
+	iss, ok := a.selected()
+	if !ok {
+		return
+	}
+	value, ok := a.prompt(fmt.Sprintf("New state for %s: ", iss.ID), youtrack.IssueField(iss, "State"), nil)
+	if !ok || strings.TrimSpace(value) == "" {
+		return
+	}
+	err := youtrack.SetIssueState(a.cfg, iss.ID, strings.TrimSpace(value))
+	if err != nil {
+		a.status = fmt.Sprintf("Error changing state: %v", err)
+		return
+	}
+	a.reload()
+	a.status = fmt.Sprintf("%s moved to %s.", iss.ID, strings.TrimSpace(value))
+}
+
+// logWork asks for a duration and a description and adds a work item to the selected issue.
+func (a *app) logWork() {
+	iss, ok := a.selected()
+	if !ok {
+		return
+	}
+	input, ok := a.prompt(fmt.Sprintf("Time to log on %s (e.g. 45m, 1h30m): ", iss.ID), "", nil)
+	if !ok || strings.TrimSpace(input) == "" {
+		return
+	}
+	duration, warning, err := youtrack.ParseWorkDuration(a.cfg, input)
+	if err != nil {
+		a.status = fmt.Sprintf("Error: %v", err)
+		return
+	}
+	description, ok := a.prompt("Description: ", "", nil)
+	if !ok {
+		return
+	}
+	item := youtrack.NewWorkItem{Duration: duration, Description: description, Date: time.Now()}
+	err = youtrack.AddWorkItem(a.cfg, iss.ID, item)
+	switch {
+	case errors.Is(err, youtrack.ErrQueued):
+		a.status = fmt.Sprintf("YouTrack is unreachable, queued %s on %s. Run 'youtrack-cli sync' when back online.", youtrack.HumanizeDuration(duration), iss.ID)
+	case err != nil:
+		a.status = fmt.Sprintf("Error adding work item: %v", err)
+		return
+	default:
+		a.reload()
+		a.status = fmt.Sprintf("Logged %s on %s.", youtrack.HumanizeDuration(duration), iss.ID)
+	}
+	if warning != "" {
+		a.status += " Warning: " + warning
+	}
+}
+
+// comment asks for a comment and adds it to the selected issue.
+func (a *app) comment() {
+	iss, ok := a.selected()
+	if !ok {
+		return
+	}
+	text, ok := a.prompt(fmt.Sprintf("Comment on %s: ", iss.ID), "", nil)
+	if !ok || strings.TrimSpace(text) == "" {
+		return
+	}
+	err := youtrack.AddComment(a.cfg, iss.ID, text)
+	if err != nil {
+		a.status = fmt.Sprintf("Error adding comment: %v", err)
+		return
+	}
+	a.status = fmt.Sprintf("Comment added to %s.", iss.ID)
+}
+
+// prompt reads a line on the status bar. Enter confirms, Esc cancels.
+// onChange, when set, is called after every edit.
+func (a *app) prompt(label, initial string, onChange func(string)) (string, bool) {
+	value := []rune(initial)
+	for {
+		a.status = label + string(value) + "█"
+		a.render()
+
+		switch key := a.readKey(); key {
+		case keyEnter:
+			a.status = ""
+			return string(value), true
+		case keyEsc, keyCtrlC:
+			a.status = ""
+			return "", false
+		case keyBack:
+			if len(value) > 0 {
+				value = value[:len(value)-1]
+			}
+		case keyUp, keyDown, keyPageUp, keyPageDown, keyResize:
+			continue
+		default:
+			if key[0] < 32 {
+				continue
+			}
+			value = append(value, []rune(key)...)
+		}
+		if onChange != nil {
+			onChange(string(value))
+		}
+	}
+}
+
+// listHeight is the number of issue rows that fit above the detail pane.
+func (a *app) listHeight() int {
+	// title, header, separator, status and help lines
+	h := a.rows - detailHeight - 5
+	if h < 3 {
+		h = 3
+	}
+	return h
+}
+
+// updateSize reads the terminal size, falling back to 24 rows of the default width.
+func (a *app) updateSize() {
+	rows, cols, ok := term.Size()
+	if !ok {
+		rows, cols = 24, term.DefaultWidth
+	}
+	a.rows, a.cols = rows, cols
+}
+
+// render redraws the whole screen.
+func (a *app) render() {
+	fmt.Fprint(a.out, clearScreen)
+	a.renderList()
+	a.renderDetail()
+
+	fmt.Fprintf(a.out, "\x1b[%d;1H%s", a.rows-1, term.Pad(a.status, a.cols))
+	help := "↑↓/jk move  / filter  s state  w log work  c comment  S sprint  r refresh  q quit"
+	fmt.Fprintf(a.out, "\x1b[%d;1H%s%s%s", a.rows, dim, term.Truncate(help, a.cols), reset)
+	a.out.Flush()
+}
+
+// renderList draws the title and the visible part of the issue list.
+func (a *app) renderList() {
+	sprint := a.sprint
+	if sprint == "" {
+		sprint = "all sprints"
+	}
+	title := fmt.Sprintf("YouTrack — %s — %d/%d issue(s)", sprint, len(a.filtered), len(a.issues))
+	if a.filter != "" {
+		title += fmt.Sprintf(" — filter %q", a.filter)
+	}
+	fmt.Fprintf(a.out, "%s%s%s\r\n", bold, term.Truncate(title, a.cols), reset)
+
+	idW, stateW, assigneeW := 12, 14, 18
+	summaryW := a.cols - idW - stateW - assigneeW - 3
+	if summaryW < 10 {
+		summaryW = 10
+	}
+	header := term.Pad("ID", idW) + " " + term.Pad("State", stateW) + " " + term.Pad("Assignee", assigneeW) + " " + "Summary"
+	fmt.Fprintf(a.out, "%s%s%s\r\n", dim, term.Truncate(header, a.cols), reset)
+	fmt.Fprintf(a.out, "%s\r\n", strings.Repeat("─", a.cols))
+
+	height := a.listHeight()
+	for r := 0; r < height; r++ {
+		i := a.offset + r
+		if i >= len(a.filtered) {
+			fmt.Fprint(a.out, "\r\n")
+			continue
+		}
+		iss := a.issues[a.filtered[i]]
+		line := term.Pad(iss.ID, idW) + " " +
+			term.Pad(youtrack.IssueField(iss, "State"), stateW) + " " +
+			term.Pad(youtrack.IssueAssignee(iss), assigneeW) + " " +
+			term.Pad(iss.Summary, summaryW)
+		if i == a.cursor {
+			fmt.Fprintf(a.out, "%s%s%s\r\n", reverse, line, reset)
+		} else {
+			fmt.Fprintf(a.out, "%s\r\n", line)
+		}
+	}
+}
+
+// renderDetail draws the detail pane of the selected issue.
+func (a *app) renderDetail() {
+	fmt.Fprintf(a.out, "%s\r\n", strings.Repeat("─", a.cols))
+	iss, ok := a.selected()
+	if !ok {
+		fmt.Fprint(a.out, "No issues.\r\n")
+		return
+	}
+
+	var sprints []string
+	for _, s := range iss.Sprints {
+		sprints = append(sprints, s.Name)
+	}
+	field := func(name string) string {
+		if v := youtrack.IssueField(iss, name); v != "" {
+			return v
+		}
+		return "N/A"
+	}
+
+	lines := []string{
+		iss.ID + " " + iss.Summary,
+		fmt.Sprintf("Type: %s   State: %s   Assignee: %s", field("Type"), field("State"), youtrack.IssueAssignee(iss)),
+		fmt.Sprintf("Estimation: %s   Spent: %s   Sprint: %s", field("Estimation"), field("Spent time"), strings.Join(sprints, ", ")),
+	}
+	lines = append(lines, strings.Split(strings.TrimSpace(iss.Description), "\n")...)
+	for i := 0; i < detailHeight-1 && i < len(lines); i++ {
+		text := term.Truncate(lines[i], a.cols)
+		if i == 0 {
+			text = bold + text + reset
+		}
+		fmt.Fprintf(a.out, "%s\r\n", text)
+	}
+}
@@ -0,0 +1,210 @@
+package youtrack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// IssueAccuracy compares the estimation of a resolved issue with the time spent on it.
+type IssueAccuracy struct {
+	Issue      Issue
+	Assignee   string
+	Estimation time.Duration
+	Spent      time.Duration
+	Ratio      float64 // Spent / Estimation; above 1 means under-estimated
+}
+
+// AccuracyBucket counts issues whose ratio lies in [Min, Max).
+type AccuracyBucket struct {
+	Label string
+	Min   float64
+	Max   float64
+	Count int
+}
+
+// AssigneeBias summarizes how an assignee's estimates compare with the time they spent.
+type AssigneeBias struct {
+	Assignee    string
+	Issues      int
+	Estimation  time.Duration
+	Spent       time.Duration
+	Ratio       float64 // Total spent / total estimation
+	MedianRatio float64
+}
+
+// AccuracyReport is the estimation accuracy of a set of issues.
+type AccuracyReport struct {
+	Issues      []IssueAccuracy // Resolved issues with both an estimation and spent time, worst first
+	Unresolved  int
+	Incomplete  int // Resolved issues lacking an estimation or spent time
+	Ratio       float64
+	MedianRatio float64
+	Buckets     []AccuracyBucket
+	ByAssignee  []AssigneeBias
+}
+
+// accuracyTolerance is the relative error still counted as an accurate estimate.
+const accuracyTolerance = 0.25
+
+// newAccuracyBuckets returns the ratio ranges of the distribution.
+func newAccuracyBuckets() []AccuracyBucket {
+	return []AccuracyBucket{
+		{Label: "< 0.5x", Min: 0, Max: 0.5},
+		{Label: "0.5x - 0.8x", Min: 0.5, Max: 1 / (1 + accuracyTolerance)},
+		{Label: "0.8x - 1.25x", Min: 1 / (1 + accuracyTolerance), Max: 1 + accuracyTolerance},
+		{Label: "1.25x - 2x", Min: 1 + accuracyTolerance, Max: 2},
+		{Label: ">= 2x", Min: 2, Max: math.Inf(1)},
+	}
+}
+
+// BuildAccuracy compares Estimation with Spent time for the resolved issues.
+func BuildAccuracy(issues []Issue) AccuracyReport {
+	report := AccuracyReport{Buckets: newAccuracyBuckets()}
+
+	var estimation, spent time.Duration
+	var ratios []float64
+	byAssignee := make(map[string]*AssigneeBias)
+	assigneeRatios := make(map[string][]float64)
+	for _, iss := range issues {
+		if iss.Resolved == 0 {
+			report.Unresolved++
+			continue
+		}
+		e, s := SumEstimation([]Issue{iss}), SumSpentTime([]Issue{iss})
+		if e == 0 || s == 0 {
+			report.Incomplete++
+			continue
+		}
+
+		a := IssueAccuracy{Issue: iss, Assignee: IssueAssignee(iss), Estimation: e, Spent: s, Ratio: float64(s) / float64(e)}
+		report.Issues = append(report.Issues, a)
+		estimation += e
+		spent += s
+		ratios = append(ratios, a.Ratio)
+
+		for i := range report.Buckets {
+			if a.Ratio >= report.Buckets[i].Min && a.Ratio < report.Buckets[i].Max {
+				report.Buckets[i].Count++
+				break
+			}
+		}
+
+		bias, ok := byAssignee[a.Assignee]
+		if !ok {
+			bias = &AssigneeBias{Assignee: a.Assignee}
+			byAssignee[a.Assignee] = bias
+		}
+		bias.Issues++
+		bias.Estimation += e
+		bias.Spent += s
+		assigneeRatios[a.Assignee] = append(assigneeRatios[a.Assignee], a.Ratio)
+	}
+
+	if estimation > 0 {
+		report.Ratio = float64(spent) / float64(estimation)
+	}
+	report.MedianRatio = median(ratios)
+
+	// Worst offenders first: the largest error in either direction
+	sort.SliceStable(report.Issues, func(i, j int) bool {
+		return math.Abs(math.Log(report.Issues[i].Ratio)) > math.Abs(math.Log(report.Issues[j].Ratio))
+	})
+
+	for name, bias := range byAssignee {
+		bias.Ratio = float64(bias.Spent) / float64(bias.Estimation)
+		bias.MedianRatio = median(assigneeRatios[name])
+		report.ByAssignee = append(report.ByAssignee, *bias)
+	}
+	sort.Slice(report.ByAssignee, func(i, j int) bool { return report.ByAssignee[i].Assignee < report.ByAssignee[j].Assignee })
+	return report
+}
+
+// median returns the median of values, or 0 when empty. values is sorted in place.
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sort.Float64s(values)
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
+}
+
+// worstOffenders returns up to worst issues whose ratio lies outside the tolerance. Issues are
+// sorted worst first, so the offenders are the leading ones.
+func worstOffenders(issues []IssueAccuracy, worst int) []IssueAccuracy {
+	var offenders []IssueAccuracy
+	for _, a := range issues {
+		if len(offenders) == worst || math.Abs(math.Log(a.Ratio)) < math.Log(1+accuracyTolerance) {
+			break
+		}
+		offenders = append(offenders, a)
+	}
+	return offenders
+}
+
+// describeBias phrases a spent/estimation ratio, e.g. "+35% (under-estimated)".
+func describeBias(ratio float64) string {
+	deviation := (ratio - 1) * 100
+	switch {
+	case math.Abs(ratio-1) < 0.05:
+		return fmt.Sprintf("%+.0f%% (on target)", deviation)
+	case ratio > 1:
+		return fmt.Sprintf("%+.0f%% (under-estimated)", deviation)
+	default:
+		return fmt.Sprintf("%+.0f%% (over-estimated)", deviation)
+	}
+}
+
+// PrintAccuracy prints the ratio distribution, the worst offenders and the bias per assignee.
+func PrintAccuracy(report AccuracyReport, worst int) {
+	fmt.Printf("Resolved issues compared: %d", len(report.Issues))
+	if report.Incomplete > 0 {
+		fmt.Printf(" (%d more without estimation or spent time)", report.Incomplete)
+	}
+	if report.Unresolved > 0 {
+		fmt.Printf(", %d unresolved skipped", report.Unresolved)
+	}
+	fmt.Println()
+	if len(report.Issues) == 0 {
+		return
+	}
+
+	fmt.Printf("Spent / estimation: %.2f overall, %.2f median, %s\n", report.Ratio, report.MedianRatio, describeBias(report.Ratio))
+
+	fmt.Println("\nDistribution of spent / estimation:")
+	maxCount := 0
+	for _, b := range report.Buckets {
+		if b.Count > maxCount {
+			maxCount = b.Count
+		}
+	}
+	for _, b := range report.Buckets {
+		bar := ""
+		if maxCount > 0 {
+			bar = strings.Repeat("█", int(math.Round(float64(b.Count)/float64(maxCount)*30)))
+		}
+		fmt.Printf("  %-13s\t%4d  %5.1f%%  %s\n", b.Label, b.Count, float64(b.Count)/float64(len(report.Issues))*100, bar)
+	}
+
+	if offenders := worstOffenders(report.Issues, worst); len(offenders) > 0 {
+		fmt.Println("\nWorst offenders:")
+		fmt.Printf("  %-12s\t%-10s\t%-10s\t%-6s\t%-20s\t%s\n", "ID", "Estimation", "Spent", "Ratio", "Assignee", "Title")
+		for _, a := range offenders {
+			fmt.Printf("  %-12s\t%-10s\t%-10s\t%-6.2f\t%-20s\t%s\n", a.Issue.ID, HumanizeDuration(a.Estimation),
+				HumanizeDuration(a.Spent), a.Ratio, a.Assignee, a.Issue.Summary)
+		}
+	}
+
+	fmt.Println("\nBias per assignee:")
+	fmt.Printf("  %-20s\t%-6s\t%-10s\t%-10s\t%-6s\t%-6s\t%s\n", "Assignee", "Issues", "Estimation", "Spent", "Ratio", "Median", "Bias")
+	for _, b := range report.ByAssignee {
+		fmt.Printf("  %-20s\t%-6d\t%-10s\t%-10s\t%-6.2f\t%-6.2f\t%s\n", b.Assignee, b.Issues, HumanizeDuration(b.Estimation),
+			HumanizeDuration(b.Spent), b.Ratio, b.MedianRatio, describeBias(b.Ratio))
+	}
+}
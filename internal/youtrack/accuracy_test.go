@@ -0,0 +1,141 @@
+package youtrack
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+// accuracyIssue returns an issue with the given assignee, Estimation and Spent time presentations.
+func accuracyIssue(id, assignee, estimation, spent string, resolved bool) Issue {
+	iss := Issue{ID: id, CustomFields: []CustomField{
+		{Name: "Assignee", Value: map[string]interface{}{"fullName": assignee}},
+		{Name: "Estimation", Value: map[string]interface{}{"presentation": estimation}},
+		{Name: "Spent time", Value: map[string]interface{}{"presentation": spent}},
+	}}
+	if resolved {
+		iss.Resolved = 1
+	}
+	return iss
+}
+
+// almostEqual compares ratios computed from durations.
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestBuildAccuracy(t *testing.T) {
+	report := BuildAccuracy([]Issue{
+		accuracyIssue("DP-1", "Alice", "4h", "4h", true),  // 1.0
+		accuracyIssue("DP-2", "Alice", "2h", "6h", true),  // 3.0
+		accuracyIssue("DP-3", "Alice", "4h", "5h", true),  // 1.25, on a bucket boundary
+		accuracyIssue("DP-4", "Bob", "8h", "2h", true),    // 0.25
+		accuracyIssue("DP-5", "Bob", "10h", "7h", true),   // 0.7
+		accuracyIssue("DP-6", "Bob", "5h", "4h30m", true), // 0.9
+		accuracyIssue("DP-7", "Bob", "5h", "4h", false),   // Unresolved
+		accuracyIssue("DP-8", "Alice", "", "3h", true),    // No estimation
+		accuracyIssue("DP-9", "Alice", "1d", "", true),    // No spent time
+	})
+
+	if report.Unresolved != 1 || report.Incomplete != 2 || len(report.Issues) != 6 {
+		t.Errorf("BuildAccuracy: %d compared, %d unresolved, %d incomplete, want 6, 1, 2",
+			len(report.Issues), report.Unresolved, report.Incomplete)
+	}
+
+	var ids []string
+	for _, a := range report.Issues {
+		ids = append(ids, a.Issue.ID)
+	}
+	if want := []string{"DP-4", "DP-2", "DP-5", "DP-3", "DP-6", "DP-1"}; !slices.Equal(ids, want) {
+		t.Errorf("BuildAccuracy order = %v, want %v (worst first)", ids, want)
+	}
+
+	var counts []int
+	for _, b := range report.Buckets {
+		counts = append(counts, b.Count)
+	}
+	if want := []int{1, 1, 2, 1, 1}; !slices.Equal(counts, want) {
+		t.Errorf("BuildAccuracy buckets = %v, want %v", counts, want)
+	}
+
+	if !almostEqual(report.Ratio, 28.5/33) || !almostEqual(report.MedianRatio, 0.95) {
+		t.Errorf("BuildAccuracy ratio = %v, median %v, want %v, 0.95", report.Ratio, report.MedianRatio, 28.5/33)
+	}
+
+	want := []AssigneeBias{
+		{Assignee: "Alice", Issues: 3, Ratio: 1.5, MedianRatio: 1.25},
+		{Assignee: "Bob", Issues: 3, Ratio: 13.5 / 23, MedianRatio: 0.7},
+	}
+	if len(report.ByAssignee) != len(want) {
+		t.Fatalf("BuildAccuracy by assignee = %+v, want %+v", report.ByAssignee, want)
+	}
+	for i, b := range report.ByAssignee {
+		if b.Assignee != want[i].Assignee || b.Issues != want[i].Issues ||
+			!almostEqual(b.Ratio, want[i].Ratio) || !almostEqual(b.MedianRatio, want[i].MedianRatio) {
+			t.Errorf("BuildAccuracy by assignee %d = %+v, want %+v", i, b, want[i])
+		}
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		values []float64
+		want   float64
+	}{
+		{nil, 0},
+		{[]float64{2}, 2},
+		{[]float64{3, 1, 2}, 2},
+		{[]float64{4, 1, 3, 2}, 2.5},
+	}
+	for _, tt := range tests {
+		if got := median(tt.values); got != tt.want {
+			t.Errorf("median(%v) = %v, want %v", tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestWorstOffenders(t *testing.T) {
+	// Sorted worst first as BuildAccuracy returns them
+	issues := []IssueAccuracy{
+		{Issue: Issue{ID: "DP-4"}, Ratio: 0.25},
+		{Issue: Issue{ID: "DP-2"}, Ratio: 3},
+		{Issue: Issue{ID: "DP-3"}, Ratio: 1.25}, // Exactly at the tolerance
+		{Issue: Issue{ID: "DP-6"}, Ratio: 0.9},
+		{Issue: Issue{ID: "DP-1"}, Ratio: 1},
+	}
+	tests := []struct {
+		worst int
+		want  []string
+	}{
+		{10, []string{"DP-4", "DP-2", "DP-3"}},
+		{2, []string{"DP-4", "DP-2"}},
+		{0, nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, a := range worstOffenders(issues, tt.worst) {
+			got = append(got, a.Issue.ID)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("worstOffenders(%d) = %v, want %v", tt.worst, got, tt.want)
+		}
+	}
+}
+
+func TestDescribeBias(t *testing.T) {
+	tests := []struct {
+		ratio float64
+		want  string
+	}{
+		{1, "+0% (on target)"},
+		{1.04, "+4% (on target)"},
+		{0.96, "-4% (on target)"},
+		{1.35, "+35% (under-estimated)"},
+		{0.5, "-50% (over-estimated)"},
+	}
+	for _, tt := range tests {
+		if got := describeBias(tt.ratio); got != tt.want {
+			t.Errorf("describeBias(%g) = %q, want %q", tt.ratio, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package youtrack
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+// activityFields is the field selection used for issue activities.
+const activityFields = "timestamp,author(login,fullName),category(id),field(name),added(name,presentation,minutes,login,fullName),removed(name,presentation,minutes,login,fullName)"
+
+// activityPageSize is the number of activities requested per page.
+const activityPageSize = 100
+
+// FetchIssueActivities fetches the change history of an issue, oldest first.
+// categories is a comma separated list of activity categories (e.g. "CustomFieldCategory").
+func FetchIssueActivities(cfg config.Config, issueID, categories string) ([]Activity, error) {
+	client := NewClient(cfg)
+
+	params := url.Values{}
+	params.Set("fields", activityFields)
+	params.Set("categories", categories)
+	params.Set("$top", fmt.Sprint(activityPageSize))
+
+	var activities []Activity
+	for skip := 0; ; skip += activityPageSize {
+		params.Set("$skip", fmt.Sprint(skip))
+		var page []Activity
+		if err := client.get(fmt.Sprintf("/api/issues/%s/activities?%s", issueID, params.Encode()), &page); err != nil {
+			return nil, err
+		}
+		activities = append(activities, page...)
+		if len(page) < activityPageSize {
+			break
+		}
+	}
+
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].Timestamp < activities[j].Timestamp
+	})
+	return activities, nil
+}
+
+// fetchActivities fetches the changes of the given categories on any issue matching issueQuery
+// between start and end, oldest first. An empty issueQuery includes all issues.
+func fetchActivities(cfg config.Config, categories string, start, end time.Time, issueQuery string) ([]Activity, error) {
+	client := NewClient(cfg)
+
+	params := url.Values{}
+	params.Set("fields", activityFields+",target(idReadable,summary)")
+	params.Set("categories", categories)
+	params.Set("start", fmt.Sprint(start.UnixMilli()))
+	params.Set("end", fmt.Sprint(end.UnixMilli()))
+	if issueQuery != "" {
+		params.Set("issueQuery", issueQuery)
+	}
+	params.Set("$top", fmt.Sprint(activityPageSize))
+
+	var activities []Activity
+	for skip := 0; ; skip += activityPageSize {
+		params.Set("$skip", fmt.Sprint(skip))
+		var page []Activity
+		if err := client.get("/api/activities?"+params.Encode(), &page); err != nil {
+			return nil, err
+		}
+		activities = append(activities, page...)
+		if len(page) < activityPageSize {
+			break
+		}
+	}
+
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].Timestamp < activities[j].Timestamp
+	})
+	return activities, nil
+}
+
+// fieldChanges returns the activities that changed the named custom field.
+func fieldChanges(activities []Activity, fieldName string) []Activity {
+	var changes []Activity
+	for _, a := range activities {
+		if a.Field != nil && a.Field.Name == fieldName {
+			changes = append(changes, a)
+		}
+	}
+	return changes
+}
+
+// activityValue converts the added/removed part of an activity into a readable string.
+// Enum and state fields report arrays of values, period fields report minutes or presentations.
+func activityValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case []interface{}:
+		if len(val) == 0 {
+			return ""
+		}
+		return activityValue(val[0])
+	case float64:
+		// Period fields report plain minutes
+		return HumanizeDuration(time.Duration(val) * time.Minute)
+	case map[string]interface{}:
+		if m, ok := val["minutes"].(float64); ok {
+			if p, ok := val["presentation"].(string); !ok || p == "" {
+				return HumanizeDuration(time.Duration(m) * time.Minute)
+			}
+		}
+		return presentation(val)
+	}
+	return presentation(v)
+}
+
+// activityValues converts the added/removed part of an activity into readable strings,
+// one per value for multi-value fields such as sprints.
+func activityValues(v interface{}) []string {
+	list, ok := v.([]interface{})
+	if !ok {
+		if s := activityValue(v); s != "" {
+			return []string{s}
+		}
+		return nil
+	}
+	var values []string
+	for _, item := range list {
+		if s := activityValue(item); s != "" {
+			values = append(values, s)
+		}
+	}
+	return values
+}
@@ -0,0 +1,86 @@
+package youtrack
+
+import (
+	"fmt"
+	"sort"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+// Aging levels of an issue.
+const (
+	AgingOK    = ""
+	AgingWarn  = "aging"
+	AgingStale = "stale"
+)
+
+// IssueAge is how long an unresolved issue has been in its current state.
+type IssueAge struct {
+	Issue Issue
+	State string
+	Since time.Time // Last change of State, or creation if it never changed
+	Age   time.Duration
+	Level string
+}
+
+// BuildAging computes the time each unresolved issue has spent in its current state from
+// the State changes in its activity history, oldest first. Comments and other edits do not
+// reset the age.
+func BuildAging(cfg config.Config, issues []Issue, now time.Time) ([]IssueAge, error) {
+	var ages []IssueAge
+	for _, iss := range issues {
+		if iss.Resolved != 0 {
+			continue
+		}
+		activities, err := FetchIssueActivities(cfg, iss.ID, "CustomFieldCategory")
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch activities of %s: %w", iss.ID, err)
+		}
+		ages = append(ages, issueAge(cfg.Aging, iss, activities, now))
+	}
+
+	sort.SliceStable(ages, func(i, j int) bool { return ages[i].Age > ages[j].Age })
+	return ages, nil
+}
+
+// issueAge derives the age of an issue in its current state.
+func issueAge(thresholds config.Aging, iss Issue, activities []Activity, now time.Time) IssueAge {
+	age := IssueAge{Issue: iss, State: IssueField(iss, "State"), Since: unixMilliToTime(iss.Created)}
+	if changes := fieldChanges(activities, "State"); len(changes) > 0 {
+		age.Since = unixMilliToTime(changes[len(changes)-1].Timestamp)
+	}
+	age.Age = now.Sub(age.Since)
+
+	warn, stale := thresholds.Thresholds(age.State)
+	switch {
+	case age.Age >= stale:
+		age.Level = AgingStale
+	case age.Age >= warn:
+		age.Level = AgingWarn
+	}
+	return age
+}
+
+// PrintAging prints unresolved issues by time in their current state, flagging aging and stale ones.
+func PrintAging(ages []IssueAge) {
+	if len(ages) == 0 {
+		fmt.Println("No unresolved issues found.")
+		return
+	}
+
+	fmt.Printf("%-12s\t%-15s\t%-8s\t%-10s\t%-7s\t%-20s\t%s\n", "ID", "State", "In State", "Since", "Flag", "Assignee", "Title")
+	counts := make(map[string]int)
+	for _, a := range ages {
+		flag := ""
+		switch a.Level {
+		case AgingStale:
+			flag = "⚠ stale"
+		case AgingWarn:
+			flag = "aging"
+		}
+		counts[a.Level]++
+		fmt.Printf("%-12s\t%-15s\t%-8s\t%-10s\t%-7s\t%-20s\t%s\n", a.Issue.ID, orNA(a.State), formatDays(a.Age),
+			a.Since.Format("2006-01-02"), flag, IssueAssignee(a.Issue), a.Issue.Summary)
+	}
+	fmt.Printf("\n%d unresolved issues: %d stale, %d aging\n", len(ages), counts[AgingStale], counts[AgingWarn])
+}
@@ -0,0 +1,487 @@
+package youtrack
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"youtrack-cli/internal/config"
+	"youtrack-cli/internal/term"
+)
+
+// boardDetailFields is the field selection used for a single board's settings.
+const boardDetailFields = "id,name,currentSprint(id,name)," +
+	"columnSettings(field(name),columns(presentation,isResolved,ordinal,wipLimit(min,max),fieldValues(name,presentation)))," +
+	"swimlaneSettings($type,enabled,field(name),values(name,presentation))," +
+	"sprintsSettings(disableSprints,isExplicit,sprintSyncField(name),cardOnSeveralSprints)," +
+	"estimationField(name),originalEstimationField(name),projects(id,shortName,name)"
+
+// maxBoardSuggestions is the number of closest boards suggested when a lookup fails.
+const maxBoardSuggestions = 3
+
+// FindBoard looks up an agile board by ID or name. Names match exactly first, then
+// ignoring case and whitespace, then by unique prefix. When nothing matches the
+// error lists the closest board names.
+func FindBoard(cfg config.Config, boardName string) (AgileBoard, error) {
+	boards, err := ListBoards(cfg)
+	if err != nil {
+		return AgileBoard{}, err
+	}
+	return matchBoard(boards, boardName)
+}
+
+// matchBoard picks the board referred to by ref.
+func matchBoard(boards []AgileBoard, ref string) (AgileBoard, error) {
+	for _, b := range boards {
+		if b.Name == ref || b.ID == ref {
+			return b, nil
+		}
+	}
+
+	key := normalizeBoardName(ref)
+	for _, b := range boards {
+		if normalizeBoardName(b.Name) == key {
+			return b, nil
+		}
+	}
+
+	var prefixed []AgileBoard
+	for _, b := range boards {
+		if key != "" && strings.HasPrefix(normalizeBoardName(b.Name), key) {
+			prefixed = append(prefixed, b)
+		}
+	}
+	switch len(prefixed) {
+	case 1:
+		return prefixed[0], nil
+	case 0:
+	default:
+		return AgileBoard{}, fmt.Errorf("board '%s' is ambiguous, it matches: %s", ref, boardNames(prefixed))
+	}
+
+	if suggestions := closestBoards(boards, key, maxBoardSuggestions); len(suggestions) > 0 {
+		return AgileBoard{}, fmt.Errorf("board '%s' not found. Did you mean: %s?", ref, boardNames(suggestions))
+	}
+	return AgileBoard{}, fmt.Errorf("board '%s' not found", ref)
+}
+
+// normalizeBoardName lowercases a name and drops whitespace for lenient comparison.
+func normalizeBoardName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), ""))
+}
+
+// closestBoards returns up to n boards ordered by edit distance to the normalized key.
+// Boards further away than half the key length (at least 3 edits) are not suggested.
+func closestBoards(boards []AgileBoard, key string, n int) []AgileBoard {
+	type scored struct {
+		board    AgileBoard
+		distance int
+	}
+	maxDistance := max(3, len([]rune(key))/2)
+	var candidates []scored
+	for _, b := range boards {
+		if d := editDistance(key, normalizeBoardName(b.Name)); d <= maxDistance {
+			candidates = append(candidates, scored{b, d})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].distance < candidates[j].distance })
+
+	var closest []AgileBoard
+	for i := 0; i < len(candidates) && i < n; i++ {
+		closest = append(closest, candidates[i].board)
+	}
+	return closest
+}
+
+// boardNames formats boards as a quoted, comma separated list with IDs.
+func boardNames(boards []AgileBoard) string {
+	var names []string
+	for _, b := range boards {
+		names = append(names, fmt.Sprintf("'%s' (%s)", b.Name, b.ID))
+	}
+	return strings.Join(names, ", ")
+}
+
+// editDistance returns the Levenshtein distance between a and b, counted in runes.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+// FetchBoardDetails fetches the column, swimlane, sprint and estimation settings of a board.
+func FetchBoardDetails(cfg config.Config, board AgileBoard) (AgileBoard, error) {
+	client := NewClient(cfg)
+	path := fmt.Sprintf("/api/agiles/%s?fields=%s", board.ID, boardDetailFields)
+
+	var details AgileBoard
+	if err := client.get(path, &details); err != nil {
+		return AgileBoard{}, err
+	}
+	return details, nil
+}
+
+// columnFieldName returns the custom field that defines the board columns.
+func columnFieldName(board AgileBoard) string {
+	if board.ColumnSettings != nil && board.ColumnSettings.Field != nil {
+		return board.ColumnSettings.Field.Name
+	}
+	return "State"
+}
+
+// issueBasedSwimlanes is the $type of swimlane settings that group cards under parent issues.
+const issueBasedSwimlanes = "IssueBasedSwimlaneSettings"
+
+// uncategorizedLane holds the cards without a parent on boards with issue based swimlanes.
+const uncategorizedLane = "Uncategorized cards"
+
+// Kanban is a board laid out as swimlanes of columns.
+type Kanban struct {
+	Board    AgileBoard
+	Sprint   Sprint
+	Columns  []BoardColumn
+	Lanes    []KanbanLane
+	Counts   []int   // Issues per column across all lanes
+	Unplaced []Issue // Issues whose column value matches no column
+}
+
+// KanbanLane is one swimlane; Cells holds the issues of each column.
+type KanbanLane struct {
+	Name  string
+	Cells [][]Issue
+}
+
+// BuildKanban places sprint issues into the columns and swimlanes of a board.
+// board must come from FetchBoardDetails.
+func BuildKanban(board AgileBoard, sprint Sprint, issues []Issue) Kanban {
+	k := Kanban{Board: board, Sprint: sprint}
+	if board.ColumnSettings != nil {
+		k.Columns = append(k.Columns, board.ColumnSettings.Columns...)
+	}
+	sort.SliceStable(k.Columns, func(i, j int) bool { return k.Columns[i].Ordinal < k.Columns[j].Ordinal })
+	k.Counts = make([]int, len(k.Columns))
+
+	columnOf := make(map[string]int)
+	for i, col := range k.Columns {
+		for _, v := range col.FieldValues {
+			columnOf[v.Name] = i
+		}
+	}
+
+	// Attribute based swimlanes keep the order configured on the board
+	laneField, issueLanes := "", false
+	lanes := make(map[string]*KanbanLane)
+	var laneOrder []string
+	addLane := func(name string) *KanbanLane {
+		if lane, ok := lanes[name]; ok {
+			return lane
+		}
+		lane := &KanbanLane{Name: name, Cells: make([][]Issue, len(k.Columns))}
+		lanes[name] = lane
+		laneOrder = append(laneOrder, name)
+		return lane
+	}
+	switch s := board.SwimlaneSettings; {
+	case s == nil || !s.Enabled:
+	case s.Type == issueBasedSwimlanes:
+		issueLanes = true
+		// Swimlane issues in the sprint are lane headers rather than cards
+		var cards []Issue
+		for _, iss := range issues {
+			if isSwimlaneIssue(s, iss) {
+				addLane(kanbanCard(iss))
+			} else {
+				cards = append(cards, iss)
+			}
+		}
+		issues = cards
+	case s.Field != nil:
+		laneField = s.Field.Name
+		for _, v := range s.Values {
+			addLane(v.Name)
+		}
+	}
+
+	fieldName := columnFieldName(board)
+	for _, iss := range issues {
+		col, ok := columnOf[IssueField(iss, fieldName)]
+		if !ok {
+			k.Unplaced = append(k.Unplaced, iss)
+			continue
+		}
+
+		laneName := ""
+		switch {
+		case issueLanes:
+			laneName = uncategorizedLane
+			if iss.Parent != nil && len(iss.Parent.Issues) > 0 {
+				p := iss.Parent.Issues[0]
+				laneName = kanbanCard(Issue{ID: p.ID, Summary: p.Summary})
+			}
+		case laneField != "":
+			laneName = IssueField(iss, laneField)
+			if laneName == "" {
+				laneName = "No " + laneField
+			}
+		}
+		lane := addLane(laneName)
+		lane.Cells[col] = append(lane.Cells[col], iss)
+		k.Counts[col]++
+	}
+
+	for _, name := range laneOrder {
+		lane := lanes[name]
+		empty := true
+		for _, cell := range lane.Cells {
+			if len(cell) > 0 {
+				empty = false
+				break
+			}
+		}
+		if !empty {
+			k.Lanes = append(k.Lanes, *lane)
+		}
+	}
+	return k
+}
+
+// isSwimlaneIssue reports whether an issue is a swimlane on a board with issue based swimlanes,
+// i.e. its swimlane field has one of the configured values.
+func isSwimlaneIssue(s *SwimlaneSettings, iss Issue) bool {
+	if s.Field == nil {
+		return false
+	}
+	value := IssueField(iss, s.Field.Name)
+	for _, v := range s.Values {
+		if v.Name == value {
+			return true
+		}
+	}
+	return false
+}
+
+// wipViolation describes how a column count breaks its WIP limit, or "" when it doesn't.
+func wipViolation(col BoardColumn, count int) string {
+	if col.WIPLimit == nil {
+		return ""
+	}
+	if col.WIPLimit.Max != nil && count > *col.WIPLimit.Max {
+		return fmt.Sprintf("over WIP max %d", *col.WIPLimit.Max)
+	}
+	if col.WIPLimit.Min != nil && count < *col.WIPLimit.Min {
+		return fmt.Sprintf("under WIP min %d", *col.WIPLimit.Min)
+	}
+	return ""
+}
+
+// columnHeader renders "Name count/max", flagged with ⚠ when the WIP limit is violated.
+func columnHeader(col BoardColumn, count int) string {
+	header := fmt.Sprintf("%s %d", col.Presentation, count)
+	if col.WIPLimit != nil && col.WIPLimit.Max != nil {
+		header = fmt.Sprintf("%s %d/%d", col.Presentation, count, *col.WIPLimit.Max)
+	}
+	if wipViolation(col, count) != "" {
+		header += " ⚠"
+	}
+	return header
+}
+
+// minKanbanColumnWidth is the narrowest column that still fits an issue ID and a few words.
+const minKanbanColumnWidth = 14
+
+// PrintKanban renders the board in the terminal, adapting to width.
+// When the columns do not fit side by side they are printed one after another.
+func PrintKanban(k Kanban, width int) {
+	if s := k.Board.SprintsSettings; s != nil && s.DisableSprints {
+		fmt.Printf("Board '%s'\n", k.Board.Name)
+	} else {
+		fmt.Printf("Board '%s' — sprint '%s'\n", k.Board.Name, k.Sprint.Name)
+	}
+	if len(k.Columns) == 0 {
+		fmt.Println("Board has no columns configured.")
+		return
+	}
+
+	const sep = " │ "
+	colWidth := (width - (len(k.Columns)-1)*term.StringWidth(sep)) / len(k.Columns)
+	if colWidth < minKanbanColumnWidth {
+		printKanbanStacked(k)
+	} else {
+		printKanbanGrid(k, colWidth, sep)
+	}
+
+	var violations []string
+	for i, col := range k.Columns {
+		if v := wipViolation(col, k.Counts[i]); v != "" {
+			violations = append(violations, fmt.Sprintf("%s (%d, %s)", col.Presentation, k.Counts[i], v))
+		}
+	}
+	if len(violations) > 0 {
+		fmt.Printf("\n⚠ WIP limit violations: %s\n", strings.Join(violations, "; "))
+	}
+	if len(k.Unplaced) > 0 {
+		fmt.Printf("\n%d issue(s) not mapped to any column by %s:", len(k.Unplaced), columnFieldName(k.Board))
+		for _, iss := range k.Unplaced {
+			fmt.Printf(" %s", iss.ID)
+		}
+		fmt.Println()
+	}
+}
+
+// printKanbanGrid prints columns side by side with one card per line.
+func printKanbanGrid(k Kanban, colWidth int, sep string) {
+	var headers, rule []string
+	for i, col := range k.Columns {
+		headers = append(headers, term.Pad(columnHeader(col, k.Counts[i]), colWidth))
+		rule = append(rule, strings.Repeat("─", colWidth))
+	}
+	fmt.Println(strings.TrimRight(strings.Join(headers, sep), " "))
+	fmt.Println(strings.Join(rule, "─┼─"))
+
+	for _, lane := range k.Lanes {
+		if lane.Name != "" {
+			fmt.Printf("▸ %s\n", lane.Name)
+		}
+		rows := 0
+		for _, cell := range lane.Cells {
+			if len(cell) > rows {
+				rows = len(cell)
+			}
+		}
+		for r := 0; r < rows; r++ {
+			var line []string
+			for _, cell := range lane.Cells {
+				text := ""
+				if r < len(cell) {
+					text = kanbanCard(cell[r])
+				}
+				line = append(line, term.Pad(text, colWidth))
+			}
+			fmt.Println(strings.TrimRight(strings.Join(line, sep), " "))
+		}
+	}
+}
+
+// printKanbanStacked prints each column as its own section for narrow terminals.
+func printKanbanStacked(k Kanban) {
+	for i, col := range k.Columns {
+		fmt.Printf("\n%s\n", columnHeader(col, k.Counts[i]))
+		for _, lane := range k.Lanes {
+			for _, iss := range lane.Cells[i] {
+				if lane.Name != "" {
+					fmt.Printf("  [%s] %s\n", lane.Name, kanbanCard(iss))
+				} else {
+					fmt.Printf("  %s\n", kanbanCard(iss))
+				}
+			}
+		}
+	}
+}
+
+// kanbanCard is the one-line representation of an issue on the board.
+func kanbanCard(iss Issue) string {
+	return iss.ID + " " + iss.Summary
+}
+
+// PrintBoardInspection prints how a board is configured: column, swimlane,
+// sprint and estimation fields and the projects it shows.
+func PrintBoardInspection(board AgileBoard) {
+	fieldName := func(f *FieldRef) string {
+		if f == nil || f.Name == "" {
+			return "(none)"
+		}
+		return f.Name
+	}
+
+	fmt.Printf("Board '%s' (ID %s)\n", board.Name, board.ID)
+
+	fmt.Printf("\nColumns (field: %s):\n", columnFieldName(board))
+	if board.ColumnSettings != nil {
+		columns := append([]BoardColumn(nil), board.ColumnSettings.Columns...)
+		sort.SliceStable(columns, func(i, j int) bool { return columns[i].Ordinal < columns[j].Ordinal })
+		for _, col := range columns {
+			var values []string
+			for _, v := range col.FieldValues {
+				values = append(values, v.Name)
+			}
+			extra := ""
+			if col.WIPLimit != nil {
+				if col.WIPLimit.Min != nil {
+					extra += fmt.Sprintf(" WIP min %d", *col.WIPLimit.Min)
+				}
+				if col.WIPLimit.Max != nil {
+					extra += fmt.Sprintf(" WIP max %d", *col.WIPLimit.Max)
+				}
+			}
+			if col.IsResolved {
+				extra += " (resolved)"
+			}
+			fmt.Printf("  %-20s\t%s%s\n", col.Presentation, strings.Join(values, ", "), extra)
+		}
+	}
+
+	fmt.Println("\nSwimlanes:")
+	switch s := board.SwimlaneSettings; {
+	case s == nil || !s.Enabled:
+		fmt.Println("  disabled")
+	case s.Type == issueBasedSwimlanes:
+		if s.Field != nil && len(s.Values) > 0 {
+			var values []string
+			for _, v := range s.Values {
+				values = append(values, v.Name)
+			}
+			fmt.Printf("  by parent issue, swimlanes are issues with %s: %s\n", s.Field.Name, strings.Join(values, ", "))
+		} else {
+			fmt.Println("  by parent issue")
+		}
+	case s.Field != nil:
+		var values []string
+		for _, v := range s.Values {
+			values = append(values, v.Name)
+		}
+		fmt.Printf("  by field %s: %s\n", s.Field.Name, strings.Join(values, ", "))
+	default:
+		fmt.Printf("  issue based (%s)\n", s.Type)
+	}
+
+	fmt.Println("\nSprints:")
+	switch s := board.SprintsSettings; {
+	case s == nil:
+		fmt.Println("  (unknown)")
+	case s.DisableSprints:
+		fmt.Println("  disabled")
+	case s.IsExplicit:
+		fmt.Println("  issues are added to sprints manually")
+	default:
+		fmt.Printf("  synced with field %s\n", fieldName(s.SprintSyncField))
+	}
+	if s := board.SprintsSettings; s != nil && s.CardOnSeveralSprints {
+		fmt.Println("  issues can belong to several sprints")
+	}
+
+	fmt.Println("\nEstimation:")
+	fmt.Printf("  estimation field:          %s\n", fieldName(board.EstimationField))
+	fmt.Printf("  original estimation field: %s\n", fieldName(board.OriginalEstimationField))
+	if board.EstimationField != nil && board.EstimationField.Name != "Estimation" {
+		fmt.Printf("  Note: youtrack-cli totals the 'Estimation' field, but this board estimates with '%s'.\n", board.EstimationField.Name)
+	}
+
+	fmt.Println("\nProjects:")
+	for _, p := range board.Projects {
+		fmt.Printf("  %-10s\t%s\n", p.ShortName, p.Name)
+	}
+}
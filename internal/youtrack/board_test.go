@@ -0,0 +1,97 @@
+package youtrack
+
+import "testing"
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "crm", 3},
+		{"crm", "", 3},
+		{"crm", "crm", 0},
+		{"crm", "cmr", 2},
+		{"kitten", "sitting", 3},
+		{"sprint", "sprnt", 1},
+		{"促案管理", "促案", 2}, // Runes, not bytes
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestMatchBoard(t *testing.T) {
+	boards := []AgileBoard{
+		{ID: "1-1", Name: "CRM Board"},
+		{ID: "1-2", Name: "Platform"},
+		{ID: "1-3", Name: "Platform Ops"},
+	}
+	tests := []struct {
+		ref     string
+		wantID  string
+		wantErr bool
+	}{
+		{ref: "CRM Board", wantID: "1-1"},
+		{ref: "1-2", wantID: "1-2"},
+		{ref: "crmboard", wantID: "1-1"}, // Case and whitespace are ignored
+		{ref: "crm", wantID: "1-1"},      // Unique prefix
+		{ref: "plat", wantErr: true},     // Ambiguous prefix
+		{ref: "Platfrom", wantErr: true}, // Typo: suggestion only
+	}
+	for _, tt := range tests {
+		got, err := matchBoard(boards, tt.ref)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("matchBoard(%q) = %s, want error", tt.ref, got.ID)
+			}
+			continue
+		}
+		if err != nil || got.ID != tt.wantID {
+			t.Errorf("matchBoard(%q) = %s, %v, want %s", tt.ref, got.ID, err, tt.wantID)
+		}
+	}
+}
+
+func TestBuildKanbanIssueBasedSwimlanes(t *testing.T) {
+	field := func(name, value string) CustomField {
+		return CustomField{Name: name, Value: map[string]interface{}{"name": value}}
+	}
+	issue := func(id, typ, state, parent string) Issue {
+		iss := Issue{ID: id, Summary: "S", CustomFields: []CustomField{field("Type", typ), field("State", state)}}
+		if parent != "" {
+			iss.Parent = &IssueParent{Issues: []IssueRef{{ID: parent, Summary: "S"}}}
+		}
+		return iss
+	}
+	board := AgileBoard{
+		ColumnSettings: &ColumnSettings{Columns: []BoardColumn{
+			{Presentation: "Open", Ordinal: 0, FieldValues: []FieldValue{{Name: "Open"}}},
+			{Presentation: "Done", Ordinal: 1, FieldValues: []FieldValue{{Name: "Done"}}},
+		}},
+		SwimlaneSettings: &SwimlaneSettings{Type: issueBasedSwimlanes, Enabled: true,
+			Field: &FieldRef{Name: "Type"}, Values: []FieldValue{{Name: "Epic"}}},
+	}
+	issues := []Issue{
+		issue("DP-1", "Epic", "Open", ""),
+		issue("DP-2", "Task", "Open", "DP-1"),
+		issue("DP-3", "Task", "Done", "DP-1"),
+		issue("DP-4", "Bug", "Done", ""),
+	}
+
+	k := BuildKanban(board, Sprint{}, issues)
+	if len(k.Lanes) != 2 {
+		t.Fatalf("got %d lanes, want 2: %+v", len(k.Lanes), k.Lanes)
+	}
+	if k.Lanes[0].Name != "DP-1 S" || len(k.Lanes[0].Cells[0]) != 1 || len(k.Lanes[0].Cells[1]) != 1 {
+		t.Errorf("epic lane = %+v, want DP-2 open and DP-3 done", k.Lanes[0])
+	}
+	if k.Lanes[1].Name != uncategorizedLane || len(k.Lanes[1].Cells[1]) != 1 || k.Lanes[1].Cells[1][0].ID != "DP-4" {
+		t.Errorf("second lane = %+v, want DP-4 uncategorized", k.Lanes[1])
+	}
+	if k.Counts[0] != 1 || k.Counts[1] != 2 {
+		t.Errorf("counts = %v, want [1 2] (the epic is a lane, not a card)", k.Counts)
+	}
+}
@@ -0,0 +1,348 @@
+package youtrack
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+// BurndownPoint is the state of a sprint at the end of one day.
+type BurndownPoint struct {
+	Date      time.Time
+	Scope     time.Duration // Estimation of all sprint issues on that day
+	Remaining time.Duration // Estimation of issues still unresolved at the end of that day
+	Ideal     time.Duration // Ideal remaining estimation for a linear burndown
+	Actual    bool          // False for days that have not happened yet
+}
+
+// Completed returns the estimation resolved by the end of the day.
+func (p BurndownPoint) Completed() time.Duration {
+	return p.Scope - p.Remaining
+}
+
+// issueHistory pairs an issue with the changes of its Estimation field and of its sprint membership.
+type issueHistory struct {
+	Issue             Issue
+	EstimationChanges []Activity
+	Additions         []ScopeChange // Additions to the sprint after its first day, oldest first
+	AddedAt           int64         // When the issue joined the sprint, 0 when it was part of it from the start
+	Removals          []ScopeChange // Removals from the sprint after its first day
+	RemovedAt         int64         // When the issue left the sprint, 0 when it is still part of it
+}
+
+// BuildBurndown reconstructs the daily remaining estimation of a sprint from
+// the Estimation history, sprint additions and resolved date of each issue.
+func BuildBurndown(cfg config.Config, board AgileBoard, sprint Sprint, issues []Issue) ([]BurndownPoint, error) {
+	histories, err := fetchSprintHistories(cfg, board, sprint, issues)
+	if err != nil {
+		return nil, err
+	}
+	return burndownSeries(sprint, histories, time.Now()), nil
+}
+
+// fetchSprintHistories fetches the activities of the sprint issues and works out when each
+// issue joined the sprint. Issues removed from the sprint after its first day are included
+// with the time they left it.
+func fetchSprintHistories(cfg config.Config, board AgileBoard, sprint Sprint, issues []Issue) ([]issueHistory, error) {
+	if sprint.Start == 0 || sprint.Finish == 0 {
+		return nil, fmt.Errorf("sprint '%s' has no start/finish dates", sprint.Name)
+	}
+	if board.SprintsSettings == nil {
+		details, err := FetchBoardDetails(cfg, board)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch settings of board '%s': %w", board.Name, err)
+		}
+		board = details
+	}
+	baseline := sprintBaseline(sprint)
+
+	histories := make([]issueHistory, 0, len(issues))
+	for _, iss := range issues {
+		activities, err := FetchIssueActivities(cfg, iss.ID, "CustomFieldCategory,SprintCategory")
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch activities for issue %s: %w", iss.ID, err)
+		}
+		h := issueHistory{Issue: iss, EstimationChanges: fieldChanges(activities, "Estimation")}
+		h.Additions = sprintAdditions(board, sprint, iss, activities, baseline)
+		if len(h.Additions) > 0 {
+			h.AddedAt = h.Additions[0].Time.UnixMilli()
+		}
+		h.Removals = sprintRemovals(board, sprint, iss, activities, baseline) // Removed and added back
+		histories = append(histories, h)
+	}
+
+	removed, err := fetchRemovedIssues(cfg, board, sprint, issues)
+	if err != nil {
+		return nil, err
+	}
+	for _, iss := range removed {
+		activities, err := FetchIssueActivities(cfg, iss.ID, "CustomFieldCategory,SprintCategory")
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch activities for issue %s: %w", iss.ID, err)
+		}
+		h := issueHistory{Issue: iss, EstimationChanges: fieldChanges(activities, "Estimation")}
+		h.Removals = sprintRemovals(board, sprint, iss, activities, baseline)
+		if len(h.Removals) == 0 {
+			continue // Removed while planning on the first day
+		}
+		h.RemovedAt = h.Removals[len(h.Removals)-1].Time.UnixMilli()
+		h.Additions = sprintAdditions(board, sprint, iss, activities, baseline)
+		if len(h.Additions) > 0 {
+			h.AddedAt = h.Additions[0].Time.UnixMilli()
+		}
+		histories = append(histories, h)
+	}
+	return histories, nil
+}
+
+// fetchRemovedIssues finds the issues that are no longer in the sprint but lost it during its
+// dates, from the sprint membership changes on the board's projects.
+func fetchRemovedIssues(cfg config.Config, board AgileBoard, sprint Sprint, issues []Issue) ([]Issue, error) {
+	syncField := sprintSyncField(board)
+	category := "SprintCategory"
+	if syncField != "" {
+		category = "CustomFieldCategory"
+	}
+	var projects []string
+	for _, p := range board.Projects {
+		projects = append(projects, p.ShortName)
+	}
+	issueQuery := ""
+	if len(projects) > 0 {
+		issueQuery = "project: " + strings.Join(projects, ", ")
+	}
+
+	activities, err := fetchActivities(cfg, category, unixMilliToTime(sprint.Start), unixMilliToTime(sprint.Finish), issueQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch sprint changes: %w", err)
+	}
+	ids := removedIssueIDs(sprint, syncField, issues, activities)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	removed, err := SearchIssues(cfg, "issue id: "+strings.Join(ids, ", "))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch issues removed from the sprint: %w", err)
+	}
+	return removed, nil
+}
+
+// removedIssueIDs returns the IDs of issues whose sprint membership lost the sprint in one of the
+// activities and that are not among the current sprint issues, in the order they were removed.
+func removedIssueIDs(sprint Sprint, syncField string, issues []Issue, activities []Activity) []string {
+	seen := make(map[string]bool)
+	for _, iss := range issues {
+		seen[iss.ID] = true
+	}
+	var ids []string
+	for _, a := range activities {
+		if a.Target == nil || seen[a.Target.ID] || !isSprintMembership(a, syncField) ||
+			!containsFold(activityValues(a.Removed), sprint.Name) {
+			continue
+		}
+		seen[a.Target.ID] = true
+		ids = append(ids, a.Target.ID)
+	}
+	return ids
+}
+
+// sprintBaseline is the end of the sprint's first day. Changes before it are planning,
+// changes after it change the scope.
+func sprintBaseline(sprint Sprint) time.Time {
+	return startOfDay(unixMilliToTime(sprint.Start)).AddDate(0, 0, 1)
+}
+
+// sprintAdditions lists when an issue was added to the sprint after the baseline, or that it
+// was created during the sprint. Boards that derive sprints from a field record the addition
+// as a change of that field.
+func sprintAdditions(board AgileBoard, sprint Sprint, iss Issue, activities []Activity, baseline time.Time) []ScopeChange {
+	syncField := sprintSyncField(board)
+	var additions []ScopeChange
+	for _, a := range activities {
+		at := unixMilliToTime(a.Timestamp)
+		if !at.After(baseline) {
+			continue
+		}
+		if isSprintMembership(a, syncField) && containsFold(activityValues(a.Added), sprint.Name) {
+			additions = append(additions, ScopeChange{Time: at, Issue: iss, Change: "added to sprint", Author: authorName(a.Author)})
+		}
+	}
+	if created := unixMilliToTime(iss.Created); len(additions) == 0 && created.After(baseline) {
+		additions = append(additions, ScopeChange{Time: created, Issue: iss, Change: "created during sprint", Author: "N/A"})
+	}
+	return additions
+}
+
+// sprintRemovals lists when an issue was removed from the sprint after the baseline.
+func sprintRemovals(board AgileBoard, sprint Sprint, iss Issue, activities []Activity, baseline time.Time) []ScopeChange {
+	syncField := sprintSyncField(board)
+	var removals []ScopeChange
+	for _, a := range activities {
+		at := unixMilliToTime(a.Timestamp)
+		if at.After(baseline) && isSprintMembership(a, syncField) && containsFold(activityValues(a.Removed), sprint.Name) {
+			removals = append(removals, ScopeChange{Time: at, Issue: iss, Change: "removed from sprint", Author: authorName(a.Author)})
+		}
+	}
+	return removals
+}
+
+// sprintSyncField returns the field the board derives sprints from, or "" when issues are
+// added to sprints explicitly.
+func sprintSyncField(board AgileBoard) string {
+	if s := board.SprintsSettings; s != nil && !s.IsExplicit && s.SprintSyncField != nil {
+		return s.SprintSyncField.Name
+	}
+	return ""
+}
+
+// isSprintMembership reports whether an activity changes the sprints of an issue: a sprint
+// change, or a change of the sync field on boards that derive sprints from a field.
+func isSprintMembership(a Activity, syncField string) bool {
+	if syncField != "" {
+		return a.Field != nil && a.Field.Name == syncField
+	}
+	return a.Category != nil && a.Category.ID == "SprintCategory"
+}
+
+// burndownSeries computes one BurndownPoint per sprint day.
+func burndownSeries(sprint Sprint, histories []issueHistory, now time.Time) []BurndownPoint {
+	first := startOfDay(unixMilliToTime(sprint.Start))
+	last := startOfDay(unixMilliToTime(sprint.Finish))
+
+	var points []BurndownPoint
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		at := day.AddDate(0, 0, 1)
+		if at.After(now) {
+			at = now
+		}
+		atMs := at.UnixMilli()
+
+		var scope, remaining []Issue
+		for _, h := range histories {
+			if h.AddedAt > atMs || (h.RemovedAt != 0 && h.RemovedAt <= atMs) {
+				continue
+			}
+			snapshot := estimationSnapshot(estimationAt(h, atMs))
+			scope = append(scope, snapshot)
+			if h.Issue.Resolved == 0 || h.Issue.Resolved > atMs {
+				remaining = append(remaining, snapshot)
+			}
+		}
+
+		points = append(points, BurndownPoint{
+			Date:      day,
+			Scope:     SumEstimation(scope),
+			Remaining: SumEstimation(remaining),
+			Actual:    !day.After(now),
+		})
+	}
+
+	// Ideal line goes from the scope on the first day to zero on the last day
+	if n := len(points); n > 1 {
+		start := points[0].Scope
+		for i := range points {
+			points[i].Ideal = time.Duration(float64(start) * float64(n-1-i) / float64(n-1))
+		}
+	}
+	return points
+}
+
+// estimationAt returns the Estimation presentation of an issue at the given time.
+// A change after that time means the value before the change (its removed part) was in effect.
+func estimationAt(h issueHistory, atMs int64) string {
+	for _, change := range h.EstimationChanges {
+		if change.Timestamp > atMs {
+			return activityValue(change.Removed)
+		}
+	}
+	return IssueField(h.Issue, "Estimation")
+}
+
+// estimationSnapshot wraps an Estimation presentation into an Issue so SumEstimation can total it.
+func estimationSnapshot(estimation string) Issue {
+	return Issue{CustomFields: []CustomField{{
+		Name:  "Estimation",
+		Value: map[string]interface{}{"presentation": estimation},
+	}}}
+}
+
+// startOfDay truncates t to local midnight.
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// PrintBurndownChart renders remaining estimation against the ideal line.
+func PrintBurndownChart(sprint Sprint, points []BurndownPoint) {
+	labels, remaining, ideal := burnLabels(points), make([]float64, len(points)), make([]float64, len(points))
+	for i, p := range points {
+		remaining[i] = math.NaN()
+		if p.Actual {
+			remaining[i] = p.Remaining.Hours()
+		}
+		ideal[i] = p.Ideal.Hours()
+	}
+
+	fmt.Printf("Burndown for sprint '%s'\n\n", sprint.Name)
+	fmt.Print(renderLineChart(labels, []chartSeries{
+		{Name: "Remaining", Mark: '●', Values: remaining},
+		{Name: "Ideal", Mark: '·', Values: ideal},
+	}, 15, "h"))
+}
+
+// PrintBurnupChart renders completed estimation against the sprint scope.
+func PrintBurnupChart(sprint Sprint, points []BurndownPoint) {
+	labels, completed, scope := burnLabels(points), make([]float64, len(points)), make([]float64, len(points))
+	for i, p := range points {
+		completed[i], scope[i] = math.NaN(), math.NaN()
+		if p.Actual {
+			completed[i] = p.Completed().Hours()
+			scope[i] = p.Scope.Hours()
+		}
+	}
+
+	fmt.Printf("Burnup for sprint '%s'\n\n", sprint.Name)
+	fmt.Print(renderLineChart(labels, []chartSeries{
+		{Name: "Completed", Mark: '●', Values: completed},
+		{Name: "Scope", Mark: '─', Values: scope},
+	}, 15, "h"))
+}
+
+// burnLabels returns the x axis labels for a burn chart.
+func burnLabels(points []BurndownPoint) []string {
+	labels := make([]string, len(points))
+	for i, p := range points {
+		labels[i] = p.Date.Format("01-02")
+	}
+	return labels
+}
+
+// WriteBurndownCSV writes the raw burndown series as CSV, durations in hours.
+// Days that have not happened yet only carry the ideal value.
+func WriteBurndownCSV(w io.Writer, points []BurndownPoint) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"date", "scope_hours", "remaining_hours", "completed_hours", "ideal_hours"}); err != nil {
+		return err
+	}
+	for _, p := range points {
+		scope, remaining, completed := "", "", ""
+		if p.Actual {
+			scope, remaining, completed = formatHours(p.Scope), formatHours(p.Remaining), formatHours(p.Completed())
+		}
+		if err := cw.Write([]string{p.Date.Format("2006-01-02"), scope, remaining, completed, formatHours(p.Ideal)}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// formatHours formats a duration as decimal hours for machine-readable output.
+func formatHours(d time.Duration) string {
+	return strconv.FormatFloat(d.Hours(), 'f', 2, 64)
+}
@@ -0,0 +1,215 @@
+package youtrack
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// estimated returns an issue with the given Estimation presentation.
+func estimated(id, estimation string, resolved time.Time) Issue {
+	iss := estimationSnapshot(estimation)
+	iss.ID = id
+	if !resolved.IsZero() {
+		iss.Resolved = resolved.UnixMilli()
+	}
+	return iss
+}
+
+// estimationChange returns an activity changing the Estimation from one presentation to another.
+func estimationChange(at time.Time, from, to string) Activity {
+	return Activity{
+		Timestamp: at.UnixMilli(),
+		Field:     &FieldRef{Name: "Estimation"},
+		Removed:   map[string]interface{}{"presentation": from},
+		Added:     map[string]interface{}{"presentation": to},
+	}
+}
+
+func TestBurndownSeries(t *testing.T) {
+	at := func(d, h int) time.Time { return time.Date(2026, 10, d, h, 0, 0, 0, time.Local) }
+	sprint := Sprint{Name: "Sprint 26", Start: at(5, 9).UnixMilli(), Finish: at(9, 18).UnixMilli()}
+	histories := []issueHistory{
+		{ // Re-estimated from 4h to 8h on the second day, resolved on the fourth
+			Issue:             estimated("DP-1", "8h", at(8, 15)),
+			EstimationChanges: []Activity{estimationChange(at(6, 12), "4h", "8h")},
+		},
+		{ // Added on the third day
+			Issue:   estimated("DP-2", "3h", time.Time{}),
+			AddedAt: at(7, 10).UnixMilli(),
+		},
+	}
+
+	h := time.Hour
+	tests := []struct {
+		name                    string
+		now                     time.Time
+		scope, remaining, ideal []time.Duration
+		actual                  []bool
+	}{
+		{
+			name:      "finished sprint",
+			now:       at(12, 9),
+			scope:     []time.Duration{4 * h, 8 * h, 11 * h, 11 * h, 11 * h},
+			remaining: []time.Duration{4 * h, 8 * h, 11 * h, 3 * h, 3 * h},
+			ideal:     []time.Duration{4 * h, 3 * h, 2 * h, 1 * h, 0},
+			actual:    []bool{true, true, true, true, true},
+		},
+		{
+			name:      "mid sprint",
+			now:       at(7, 12), // After DP-2 joined
+			scope:     []time.Duration{4 * h, 8 * h, 11 * h, 11 * h, 11 * h},
+			remaining: []time.Duration{4 * h, 8 * h, 11 * h, 11 * h, 11 * h},
+			ideal:     []time.Duration{4 * h, 3 * h, 2 * h, 1 * h, 0},
+			actual:    []bool{true, true, true, false, false},
+		},
+	}
+	for _, tt := range tests {
+		points := burndownSeries(sprint, histories, tt.now)
+		if len(points) != len(tt.scope) {
+			t.Fatalf("%s: got %d points, want %d", tt.name, len(points), len(tt.scope))
+		}
+		for i, p := range points {
+			if want := at(5+i, 0); !p.Date.Equal(want) {
+				t.Errorf("%s: point %d date = %v, want %v", tt.name, i, p.Date, want)
+			}
+			if p.Scope != tt.scope[i] || p.Remaining != tt.remaining[i] || p.Ideal != tt.ideal[i] || p.Actual != tt.actual[i] {
+				t.Errorf("%s: point %d = scope %v, remaining %v, ideal %v, actual %v, want %v, %v, %v, %v", tt.name, i,
+					p.Scope, p.Remaining, p.Ideal, p.Actual, tt.scope[i], tt.remaining[i], tt.ideal[i], tt.actual[i])
+			}
+		}
+	}
+}
+
+func TestSprintAdditions(t *testing.T) {
+	at := func(d, h int) time.Time { return time.Date(2026, 10, d, h, 0, 0, 0, time.Local) }
+	sprint := Sprint{Name: "Sprint 26", Start: at(5, 9).UnixMilli(), Finish: at(9, 18).UnixMilli()}
+	baseline := sprintBaseline(sprint)
+	explicit := AgileBoard{SprintsSettings: &SprintsSettings{IsExplicit: true}}
+	synced := AgileBoard{SprintsSettings: &SprintsSettings{SprintSyncField: &FieldRef{Name: "Iteration"}}}
+	addedTo := func(when time.Time, category, field string) Activity {
+		a := Activity{Timestamp: when.UnixMilli(), Added: []interface{}{map[string]interface{}{"name": "Sprint 26"}}}
+		if category != "" {
+			a.Category = &Category{ID: category}
+		}
+		if field != "" {
+			a.Field = &FieldRef{Name: field}
+		}
+		return a
+	}
+	planned := Issue{ID: "DP-1", Created: at(1, 9).UnixMilli()}
+
+	tests := []struct {
+		name       string
+		board      AgileBoard
+		iss        Issue
+		activities []Activity
+		want       []string
+	}{
+		{"planned on the first day", explicit, planned, []Activity{addedTo(at(5, 11), "SprintCategory", "")}, nil},
+		{"added later", explicit, planned, []Activity{addedTo(at(7, 10), "SprintCategory", "")}, []string{"added to sprint"}},
+		{"sync field", synced, planned, []Activity{addedTo(at(7, 10), "CustomFieldCategory", "Iteration")}, []string{"added to sprint"}},
+		{"sync board ignores sprint category", synced, planned, []Activity{addedTo(at(7, 10), "SprintCategory", "")}, nil},
+		{"created during sprint", explicit, Issue{ID: "DP-2", Created: at(8, 9).UnixMilli()}, nil, []string{"created during sprint"}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, c := range sprintAdditions(tt.board, sprint, tt.iss, tt.activities, baseline) {
+			got = append(got, c.Change)
+		}
+		if len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) {
+			t.Errorf("sprintAdditions(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBurndownSeriesRemoval(t *testing.T) {
+	at := func(d, h int) time.Time { return time.Date(2026, 10, d, h, 0, 0, 0, time.Local) }
+	sprint := Sprint{Name: "Sprint 26", Start: at(5, 9).UnixMilli(), Finish: at(9, 18).UnixMilli()}
+	histories := []issueHistory{
+		{Issue: estimated("DP-1", "4h", time.Time{})},
+		{ // Planned, then removed on the third day
+			Issue:     estimated("DP-2", "2h", time.Time{}),
+			RemovedAt: at(7, 10).UnixMilli(),
+		},
+	}
+
+	h := time.Hour
+	wantScope := []time.Duration{6 * h, 6 * h, 4 * h, 4 * h, 4 * h}
+	points := burndownSeries(sprint, histories, at(12, 9))
+	if len(points) != len(wantScope) {
+		t.Fatalf("got %d points, want %d", len(points), len(wantScope))
+	}
+	for i, p := range points {
+		if p.Scope != wantScope[i] || p.Remaining != wantScope[i] {
+			t.Errorf("point %d = scope %v, remaining %v, want %v", i, p.Scope, p.Remaining, wantScope[i])
+		}
+	}
+	if points[0].Ideal != 6*h {
+		t.Errorf("ideal start = %v, want %v", points[0].Ideal, 6*h)
+	}
+}
+
+func TestSprintRemovals(t *testing.T) {
+	at := func(d, h int) time.Time { return time.Date(2026, 10, d, h, 0, 0, 0, time.Local) }
+	sprint := Sprint{Name: "Sprint 26", Start: at(5, 9).UnixMilli(), Finish: at(9, 18).UnixMilli()}
+	baseline := sprintBaseline(sprint)
+	explicit := AgileBoard{SprintsSettings: &SprintsSettings{IsExplicit: true}}
+	synced := AgileBoard{SprintsSettings: &SprintsSettings{SprintSyncField: &FieldRef{Name: "Iteration"}}}
+	removedFrom := func(when time.Time, category, field, target string) Activity {
+		a := Activity{Timestamp: when.UnixMilli(), Removed: []interface{}{map[string]interface{}{"name": "Sprint 26"}}}
+		if category != "" {
+			a.Category = &Category{ID: category}
+		}
+		if field != "" {
+			a.Field = &FieldRef{Name: field}
+		}
+		if target != "" {
+			a.Target = &IssueRef{ID: target}
+		}
+		return a
+	}
+	iss := Issue{ID: "DP-1", Created: at(1, 9).UnixMilli()}
+
+	tests := []struct {
+		name       string
+		board      AgileBoard
+		activities []Activity
+		want       int
+	}{
+		{"removed while planning", explicit, []Activity{removedFrom(at(5, 11), "SprintCategory", "", "")}, 0},
+		{"removed later", explicit, []Activity{removedFrom(at(7, 10), "SprintCategory", "", "")}, 1},
+		{"sync field", synced, []Activity{removedFrom(at(7, 10), "CustomFieldCategory", "Iteration", "")}, 1},
+		{"sync board ignores sprint category", synced, []Activity{removedFrom(at(7, 10), "SprintCategory", "", "")}, 0},
+		{"removed twice", explicit, []Activity{removedFrom(at(6, 10), "SprintCategory", "", ""), removedFrom(at(8, 10), "SprintCategory", "", "")}, 2},
+	}
+	for _, tt := range tests {
+		got := sprintRemovals(tt.board, sprint, iss, tt.activities, baseline)
+		if len(got) != tt.want {
+			t.Errorf("sprintRemovals(%s) = %d removals, want %d", tt.name, len(got), tt.want)
+		}
+		for _, c := range got {
+			if c.Change != "removed from sprint" || c.Issue.ID != "DP-1" {
+				t.Errorf("sprintRemovals(%s) = %+v, want DP-1 removed from sprint", tt.name, c)
+			}
+		}
+	}
+
+	other := removedFrom(at(7, 10), "SprintCategory", "", "DP-4")
+	other.Removed = []interface{}{map[string]interface{}{"name": "Sprint 25"}}
+	activities := []Activity{
+		removedFrom(at(6, 10), "SprintCategory", "", "DP-3"),
+		removedFrom(at(6, 11), "SprintCategory", "", "DP-1"), // Back in the sprint
+		removedFrom(at(7, 10), "SprintCategory", "", "DP-2"),
+		removedFrom(at(8, 10), "SprintCategory", "", "DP-3"),
+		other,
+		removedFrom(at(8, 11), "", "Iteration", "DP-5"),
+	}
+	got := removedIssueIDs(sprint, "", []Issue{iss}, activities)
+	if want := []string{"DP-3", "DP-2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("removedIssueIDs = %v, want %v", got, want)
+	}
+	if got, want := removedIssueIDs(sprint, "Iteration", []Issue{iss}, activities), []string{"DP-5"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("removedIssueIDs(Iteration) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,83 @@
+package youtrack
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// chartSeries is one line of a terminal chart.
+type chartSeries struct {
+	Name   string
+	Mark   rune
+	Values []float64 // NaN values are not drawn
+}
+
+// chartColumnWidth is the number of terminal cells used per x value.
+const chartColumnWidth = 3
+
+// renderLineChart renders series over shared x labels as a Unicode chart.
+// The first series is drawn on top when points overlap.
+func renderLineChart(labels []string, series []chartSeries, height int, unit string) string {
+	if height < 2 {
+		height = 2
+	}
+
+	maxValue := 0.0
+	for _, s := range series {
+		for _, v := range s.Values {
+			if !math.IsNaN(v) && v > maxValue {
+				maxValue = v
+			}
+		}
+	}
+	if maxValue == 0 {
+		maxValue = 1
+	}
+
+	// grid[row][col], row 0 is the top line
+	grid := make([][]rune, height)
+	for r := range grid {
+		grid[r] = []rune(strings.Repeat(" ", len(labels)*chartColumnWidth))
+	}
+	for i := len(series) - 1; i >= 0; i-- {
+		for col, v := range series[i].Values {
+			if col >= len(labels) || math.IsNaN(v) {
+				continue
+			}
+			row := height - 1 - int(math.Round(v/maxValue*float64(height-1)))
+			grid[row][col*chartColumnWidth+chartColumnWidth-1] = series[i].Mark
+		}
+	}
+
+	var sb strings.Builder
+	for r, line := range grid {
+		// Label the top, middle and bottom rows of the y axis
+		axis := ""
+		if r == 0 || r == height-1 || r == (height-1)/2 {
+			axis = fmt.Sprintf("%.1f%s", maxValue*float64(height-1-r)/float64(height-1), unit)
+		}
+		fmt.Fprintf(&sb, "%8s │%s\n", axis, strings.TrimRight(string(line), " "))
+	}
+	fmt.Fprintf(&sb, "%8s └%s\n", "", strings.Repeat("─", len(labels)*chartColumnWidth))
+
+	// Place x labels where they fit without overlapping the previous one
+	labelLine := []rune(strings.Repeat(" ", len(labels)*chartColumnWidth+10))
+	next := 0
+	for col, label := range labels {
+		pos := col*chartColumnWidth + chartColumnWidth - 1
+		if pos < next {
+			continue
+		}
+		copy(labelLine[pos:], []rune(label))
+		next = pos + len([]rune(label)) + 1
+	}
+	fmt.Fprintf(&sb, "%8s  %s\n", "", strings.TrimRight(string(labelLine), " "))
+
+	var legend []string
+	for _, s := range series {
+		legend = append(legend, fmt.Sprintf("%c %s", s.Mark, s.Name))
+	}
+	fmt.Fprintf(&sb, "%8s  %s\n", "", strings.Join(legend, "   "))
+	return sb.String()
+}
@@ -33,52 +33,46 @@ func NewClient(cfg config.Config) *Client {
 	}
 }
 
-// get performs a GET request to the YouTrack API and decodes the response into v.
-func (c *Client) get(path string, v interface{}) error {
-	apiURL := fmt.Sprintf("%s%s", c.BaseURL, path)
-	req, err := http.NewRequest("GET", apiURL, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Authorization", "Bearer "+c.Token)
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
-	}
-	defer resp.Body.Close()
+// apiError is an error response of the YouTrack API.
+type apiError struct {
+	StatusCode int
+	Status     string
+	Body       string
+}
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API request failed with status %s: %s", resp.Status, string(body))
-	}
+func (e *apiError) Error() string {
+	return fmt.Sprintf("API request failed with status %s: %s", e.Status, e.Body)
+}
 
-	if v != nil {
-		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
-			return fmt.Errorf("failed to decode response: %w", err)
-		}
-	}
-	return nil
+// get performs a GET request to the YouTrack API and decodes the response into v.
+func (c *Client) get(path string, v interface{}) error {
+	return c.do("GET", path, nil, v)
 }
 
-// post performs a POST request to the YouTrack API with a JSON body and decodes the response into v.
-func (c *Client) post(path string, body interface{}, v interface{}) error {
+// do performs a request to the YouTrack API. A non-nil body is sent as JSON and
+// a non-nil v receives the decoded response.
+func (c *Client) do(method, path string, body interface{}, v interface{}) error {
 	apiURL := fmt.Sprintf("%s%s", c.BaseURL, path)
-	jsonData, err := json.Marshal(body)
-	if err != nil {
-		return fmt.Errorf("failed to marshal request body: %w", err)
+
+	var reqBody io.Reader
+	if body != nil {
+		jsonData, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reqBody = bytes.NewBuffer(jsonData)
 	}
 
-	req, err := http.NewRequest("POST", apiURL, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequest(method, apiURL, reqBody)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", "Bearer "+c.Token)
-	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
@@ -88,7 +82,7 @@ func (c *Client) post(path string, body interface{}, v interface{}) error {
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API request failed with status %s: %s", resp.Status, string(bodyBytes))
+		return &apiError{StatusCode: resp.StatusCode, Status: resp.Status, Body: string(bodyBytes)}
 	}
 
 	if v != nil {
@@ -101,37 +95,65 @@ func (c *Client) post(path string, body interface{}, v interface{}) error {
 
 // --- YouTrack API specific functions ---
 
-// FetchIssues fetches YouTrack issues based on a query.
-func FetchIssues(cfg config.Config, query string) ([]Issue, error) {
+// issueFields is the field selection used whenever full issues are fetched.
+const issueFields = "idReadable,summary,description,created,resolved,customFields(name,value(login,fullName,presentation,name,minutes)),assignee(fullName,login),parent(issues(idReadable,summary))"
+
+// issuePageSize is the number of issues requested per page.
+const issuePageSize = 100
+
+// SearchIssues fetches all YouTrack issues matching a query, without their sprints.
+func SearchIssues(cfg config.Config, query string) ([]Issue, error) {
 	client := NewClient(cfg)
-	fields := "idReadable,summary,customFields(name,value(login,fullName,presentation,name)),assignee(fullName,login)"
-	encodedQuery := url.QueryEscape(query)
-	path := fmt.Sprintf("/api/issues?fields=%s&query=%s", fields, encodedQuery)
+
+	params := url.Values{}
+	params.Set("fields", issueFields)
+	params.Set("query", query)
+	params.Set("$top", fmt.Sprint(issuePageSize))
 
 	var issues []Issue
-	if err := client.get(path, &issues); err != nil {
-		return nil, err
+	for skip := 0; ; skip += issuePageSize {
+		params.Set("$skip", fmt.Sprint(skip))
+		var page []Issue
+		if err := client.get("/api/issues?"+params.Encode(), &page); err != nil {
+			return nil, err
+		}
+		issues = append(issues, page...)
+		if len(page) < issuePageSize {
+			break
+		}
 	}
+	return issues, nil
+}
 
+// FetchIssues fetches YouTrack issues based on a query together with their sprints.
+// Issues whose sprints cannot be fetched are still returned, with a warning for the caller to show.
+func FetchIssues(cfg config.Config, query string) ([]Issue, []string, error) {
+	issues, err := SearchIssues(cfg, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client := NewClient(cfg)
 	// Fetch sprints for each issue
+	var warnings []string
 	for i := range issues {
 		sprintsPath := fmt.Sprintf("/api/issues/%s/sprints?fields=id,name", issues[i].ID)
 		var issueSprints []Sprint
 		if err := client.get(sprintsPath, &issueSprints); err != nil {
-			// Log warning but continue if sprint fetching fails for a single issue
-			fmt.Printf("Warning: Could not fetch sprints for issue %s: %v\n", issues[i].ID, err)
+			// Continue if sprint fetching fails for a single issue
+			warnings = append(warnings, fmt.Sprintf("could not fetch sprints for issue %s: %v", issues[i].ID, err))
 		} else {
 			issues[i].Sprints = issueSprints
 		}
 	}
 
-	return issues, nil
+	return issues, warnings, nil
 }
 
 // ListBoards fetches all agile boards.
 func ListBoards(cfg config.Config) ([]AgileBoard, error) {
 	client := NewClient(cfg)
-	fields := "id,name"
+	fields := "id,name,currentSprint(id,name)"
 	path := fmt.Sprintf("/api/agiles?fields=%s", fields)
 
 	var boards []AgileBoard
@@ -143,29 +165,18 @@ func ListBoards(cfg config.Config) ([]AgileBoard, error) {
 
 // ListSprints fetches sprints for a given board name.
 func ListSprints(cfg config.Config, boardName string) ([]Sprint, error) {
-	client := NewClient(cfg)
-
-	// First, get all boards to find the ID of the specified board
-	boards, err := ListBoards(cfg) // Reuse ListBoards
+	board, err := FindBoard(cfg, boardName)
 	if err != nil {
 		return nil, err
 	}
+	return ListBoardSprints(cfg, board)
+}
 
-	var boardID string
-	for _, b := range boards {
-		if b.Name == boardName {
-			boardID = b.ID
-			break
-		}
-	}
-
-	if boardID == "" {
-		return nil, fmt.Errorf("board '%s' not found", boardName)
-	}
-
-	// Now, get the sprints for that board
-	fields := "id,name,isCurrent,start,finish"
-	path := fmt.Sprintf("/api/agiles/%s/sprints?fields=%s", boardID, fields)
+// ListBoardSprints fetches the sprints of an already resolved board.
+func ListBoardSprints(cfg config.Config, board AgileBoard) ([]Sprint, error) {
+	client := NewClient(cfg)
+	fields := "id,name,goal,isCurrent,archived,start,finish"
+	path := fmt.Sprintf("/api/agiles/%s/sprints?fields=%s", board.ID, fields)
 
 	var sprints []Sprint
 	if err := client.get(path, &sprints); err != nil {
@@ -174,66 +185,158 @@ func ListSprints(cfg config.Config, boardName string) ([]Sprint, error) {
 	return sprints, nil
 }
 
-// AddWorkItem adds a work item to a YouTrack issue.
-func AddWorkItem(cfg config.Config, issueID, minutes, description string) error {
+// FetchSprintIssues fetches the issues that belong to a sprint of a board.
+func FetchSprintIssues(cfg config.Config, board AgileBoard, sprint Sprint) ([]Issue, error) {
+	client := NewClient(cfg)
+	path := fmt.Sprintf("/api/agiles/%s/sprints/%s?fields=issues(%s)", board.ID, sprint.ID, issueFields)
+
+	var result struct {
+		Issues []Issue `json:"issues"`
+	}
+	if err := client.get(path, &result); err != nil {
+		return nil, err
+	}
+	return result.Issues, nil
+}
+
+// NewWorkItem describes a work item to be added with AddWorkItem.
+type NewWorkItem struct {
+	Duration    time.Duration
+	Description string
+	Date        time.Time // Day the work was done, zero for today
+	Type        *WorkItemType
+	Attributes  []WorkItemAttribute // Resolved with ResolveWorkItemAttributes
+}
+
+// AddWorkItem adds a work item to a YouTrack issue. If YouTrack cannot be reached
+// the work item is queued for 'youtrack-cli sync' and ErrQueued is returned.
+func AddWorkItem(cfg config.Config, issueID string, item NewWorkItem) error {
+	if item.Duration < time.Minute {
+		return fmt.Errorf("duration must be at least one minute")
+	}
+	if item.Date.IsZero() {
+		// An explicit day keeps a queued work item on the day it was done
+		item.Date = time.Now()
+	}
+
 	client := NewClient(cfg)
 	path := fmt.Sprintf("/api/issues/%s/timeTracking/workItems?fields=date,duration(minutes),author(login),text", issueID)
 
 	workItem := map[string]interface{}{
-		"duration": map[string]string{"presentation": minutes + "m"},
-		"text":     description,
+		"duration": map[string]int{"minutes": int(item.Duration / time.Minute)},
+		"text":     item.Description,
+	}
+	// Local noon keeps the same calendar day whatever time zone the server renders it in
+	y, m, d := item.Date.Date()
+	workItem["date"] = time.Date(y, m, d, 12, 0, 0, 0, item.Date.Location()).UnixMilli()
+	if item.Type != nil {
+		workItem["type"] = map[string]string{"id": item.Type.ID}
+	}
+	if len(item.Attributes) > 0 {
+		var attrs []map[string]interface{}
+		for _, attr := range item.Attributes {
+			attrs = append(attrs, map[string]interface{}{"id": attr.ID, "value": map[string]string{"id": attr.Value.ID}})
+		}
+		workItem["attributes"] = attrs
 	}
 
-	return client.post(path, workItem, nil)
+	summary := fmt.Sprintf("add %s of work to %s on %s", HumanizeDuration(item.Duration), issueID, item.Date.Format("2006-01-02"))
+	queued := &QueuedWorkItem{IssueID: issueID, Date: item.Date, Minutes: int(item.Duration / time.Minute), Text: item.Description}
+	if item.Type != nil {
+		queued.Type = item.Type.Name
+	}
+	for _, attr := range item.Attributes {
+		if attr.Value == nil {
+			continue
+		}
+		if queued.Attributes == nil {
+			queued.Attributes = make(map[string]string)
+		}
+		queued.Attributes[attr.Name] = attr.Value.Name
+	}
+	return client.mutate("POST", path, workItem, summary, queued)
 }
 
-// CheckWork checks for issues with no work logged today.
-func CheckWork(cfg config.Config) ([]string, error) {
+// WorkHoursPerDay fetches how many hours make up a day in the time tracking settings.
+func WorkHoursPerDay(cfg config.Config) (float64, error) {
 	client := NewClient(cfg)
-	path := "/api/issues?fields=idReadable,summary,updated&query=for:me"
+	var settings struct {
+		MinutesADay int `json:"minutesADay"`
+	}
+	if err := client.get("/api/admin/timeTrackingSettings/workTimeSettings?fields=minutesADay", &settings); err != nil {
+		return 0, err
+	}
+	if settings.MinutesADay <= 0 {
+		return 0, fmt.Errorf("time tracking settings do not define the length of a day")
+	}
+	return float64(settings.MinutesADay) / 60, nil
+}
+
+// WorkItemUpdate holds the changes applied by UpdateWorkItem; empty fields are left unchanged.
+type WorkItemUpdate struct {
+	Duration string  // YouTrack duration presentation, e.g. "1h 30m"
+	Text     *string // nil keeps the current text
+}
+
+// UpdateWorkItem changes the duration and/or text of an existing work item.
+func UpdateWorkItem(cfg config.Config, issueID, itemID string, update WorkItemUpdate) error {
+	client := NewClient(cfg)
+	path := fmt.Sprintf("/api/issues/%s/timeTracking/workItems/%s?fields=id", issueID, itemID)
 
-	var issues []struct {
-		ID      string `json:"idReadable"`
-		Summary string `json:"summary"`
-		Updated int64  `json:"updated"`
+	body := map[string]interface{}{}
+	if update.Duration != "" {
+		body["duration"] = map[string]string{"presentation": update.Duration}
 	}
-	if err := client.get(path, &issues); err != nil {
-		return nil, err
+	if update.Text != nil {
+		body["text"] = *update.Text
+	}
+	if len(body) == 0 {
+		return fmt.Errorf("nothing to update")
 	}
 
-	today := time.Now().Truncate(24 * time.Hour)
-	var issuesWithoutWork []string
+	return client.mutate("POST", path, body, fmt.Sprintf("update work item %s of %s", itemID, issueID), nil)
+}
 
-	for _, issue := range issues {
-		workItemsPath := fmt.Sprintf("/api/issues/%s/timeTracking/workItems?fields=date", issue.ID)
-		var workItems []WorkItem
-		if err := client.get(workItemsPath, &workItems); err != nil {
-			// Log warning but continue if fetching work items fails for a single issue
-			fmt.Printf("Warning: Could not fetch work items for issue %s: %v\n", issue.ID, err)
-			continue
-		}
+// DeleteWorkItem removes a work item from an issue.
+func DeleteWorkItem(cfg config.Config, issueID, itemID string) error {
+	client := NewClient(cfg)
+	path := fmt.Sprintf("/api/issues/%s/timeTracking/workItems/%s", issueID, itemID)
+	return client.mutate("DELETE", path, nil, fmt.Sprintf("delete work item %s of %s", itemID, issueID), nil)
+}
 
-		hasWorkToday := false
-		for _, item := range workItems {
-			itemDate := time.Unix(item.Date/1000, 0)
-			if itemDate.Truncate(24 * time.Hour).Equal(today) {
-				hasWorkToday = true
-				break
-			}
-		}
+// AddComment adds a comment to a YouTrack issue. Comments are never queued, since
+// sending one twice would post it twice.
+func AddComment(cfg config.Config, issueID, text string) error {
+	client := NewClient(cfg)
+	path := fmt.Sprintf("/api/issues/%s/comments?fields=id", issueID)
+	return client.send("POST", path, map[string]string{"text": text})
+}
 
-		if !hasWorkToday {
-			issuesWithoutWork = append(issuesWithoutWork, fmt.Sprintf("%s: %s", issue.ID, issue.Summary))
-		}
+// ApplyCommand applies a YouTrack command (e.g. "State In Progress") to an issue.
+// Commands are never queued, since applying one twice is not always harmless.
+func ApplyCommand(cfg config.Config, issueID, command string) error {
+	client := NewClient(cfg)
+	body := map[string]interface{}{
+		"query":  command,
+		"issues": []map[string]string{{"idReadable": issueID}},
 	}
-	return issuesWithoutWork, nil
+	return client.send("POST", "/api/commands", body)
+}
+
+// SetIssueState moves an issue to the given State value.
+func SetIssueState(cfg config.Config, issueID, state string) error {
+	// 指令語法中含空格的值需要用大括號包起來
+	if strings.Contains(state, " ") {
+		state = "{" + state + "}"
+	}
+	return ApplyCommand(cfg, issueID, "State "+state)
 }
 
 // BuildQuery constructs the YouTrack query string.
 // sprintName 可為 ""；assigneeName 建議支援 "me" / "unassigned" / 指定使用者。
-// boardName 必須有值才能使用 sprint 過濾。
+// boardName 必須有值才能使用 sprint 過濾；沒有 boardName 時回傳不含 sprint 過濾的 query 與 error。
 // 新增：issueType 參數
-func BuildQuery(sprintName, assigneeName, issueType, boardName string) string {
+func BuildQuery(sprintName, assigneeName, issueType, boardName string) (string, error) {
 	var parts []string
 
 	// 1) 處理指派人過濾
@@ -255,20 +358,22 @@ func BuildQuery(sprintName, assigneeName, issueType, boardName string) string {
 	// 3) 處理 Sprint 過濾
 	if sprintName != "" {
 		if boardName == "" {
-			fmt.Println("Error: Board name is not configured. Use `youtrack-cli config set board ...`")
-			return strings.Join(parts, " ") // 如果沒有 boardName，則不進行 sprint 過濾
+			// 如果沒有 boardName，則不進行 sprint 過濾
+			return strings.Join(parts, " "), fmt.Errorf("board name is not configured, use `youtrack-cli config set board ...`")
 		}
-		// YouTrack 查詢語法中，Board 和 Sprint 名稱如果包含空格，需要用雙引號包起來
-		// 但在 BuildQuery 內部，我們只構建語法，不進行 URL 編碼
-		boardPart := fmt.Sprintf("Board %s:", boardName) // 退回變更：移除雙引號
-		sprintPart := fmt.Sprintf("{%s}", sprintName)    // 退回變更：移除雙引號
-		parts = append(parts, boardPart+" "+sprintPart)
+		parts = append(parts, SprintQuery(boardName, sprintName))
 	}
 
-	query := strings.Join(parts, " ")
-	fmt.Println("Debug Query:", query) // 保留除錯輸出
+	return strings.Join(parts, " "), nil
+}
 
-	return query
+// SprintQuery returns the query part selecting the issues of a sprint on a board.
+func SprintQuery(boardName, sprintName string) string {
+	// YouTrack 查詢語法中，Board 和 Sprint 名稱如果包含空格，需要用雙引號包起來
+	// 但在這裡我們只構建語法，不進行 URL 編碼
+	boardPart := fmt.Sprintf("Board %s:", boardName) // 退回變更：移除雙引號
+	sprintPart := fmt.Sprintf("{%s}", sprintName)    // 退回變更：移除雙引號
+	return boardPart + " " + sprintPart
 }
 
 // PrintIssues prints YouTrack issues in a formatted table.
@@ -348,6 +453,36 @@ func presentation(v interface{}) string {
 	return fmt.Sprintf("%v", v)
 }
 
+// IssueField 取得 issue 某個 custom field 的可閱讀值，找不到時回傳 ""
+func IssueField(iss Issue, name string) string {
+	for _, cf := range iss.CustomFields {
+		if cf.Name == name {
+			return presentation(cf.Value)
+		}
+	}
+	return ""
+}
+
+// IssueAssignee 取得 issue 的指派人 (多人以逗號串接)，沒有指派時回傳 "unassigned"
+func IssueAssignee(iss Issue) string {
+	if names := issueAssigneeNames(iss); len(names) > 0 {
+		return strings.Join(names, ", ")
+	}
+	return "unassigned"
+}
+
+// 取得 issue 所有指派人的全名
+func issueAssigneeNames(iss Issue) []string {
+	for _, cf := range iss.CustomFields {
+		if cf.Name == "Assignee" || cf.Name == "Assignee(s)" {
+			if names := extractAssigneeNames(cf.Value); len(names) > 0 {
+				return names
+			}
+		}
+	}
+	return nil
+}
+
 // 從 Assignee custom field 提取人名 (支援單人 / 多人陣列)
 func extractAssigneeNames(v interface{}) []string {
 	var names []string
@@ -376,11 +511,26 @@ func PrintBoards(boards []AgileBoard) {
 	}
 }
 
-// PrintSprints prints sprints for a given board in a formatted list.
-func PrintSprints(boardName string, sprints []Sprint) {
-	fmt.Printf("Sprints in board '%s':\n", boardName)
+// PrintSprints prints sprints for a given board in chronological order,
+// marking the ones the current/next/previous keywords resolve to.
+func PrintSprints(board AgileBoard, sprints []Sprint) {
+	sortSprints(sprints)
+	labels := sprintLabels(board, sprints, time.Now())
+
+	fmt.Printf("Sprints in board '%s':\n", board.Name)
 	for _, sprint := range sprints {
-		fmt.Println(sprint.Name)
+		var tags []string
+		if label, ok := labels[sprint.ID]; ok {
+			tags = append(tags, label)
+		}
+		if sprint.IsArchived {
+			tags = append(tags, "archived")
+		}
+		if len(tags) > 0 {
+			fmt.Printf("%-30s\t(%s)\n", sprint.Name, strings.Join(tags, ", "))
+		} else {
+			fmt.Println(sprint.Name)
+		}
 	}
 }
 
@@ -414,10 +564,20 @@ func parseEstimation(str string) time.Duration {
 
 // SumEstimation calculates the total estimation from a slice of Issues.
 func SumEstimation(issues []Issue) time.Duration {
+	return sumPeriodField(issues, "Estimation")
+}
+
+// SumSpentTime calculates the total spent time from a slice of Issues.
+func SumSpentTime(issues []Issue) time.Duration {
+	return sumPeriodField(issues, "Spent time")
+}
+
+// sumPeriodField sums a period custom field (e.g. Estimation) over a slice of Issues.
+func sumPeriodField(issues []Issue, fieldName string) time.Duration {
 	var total time.Duration
 	for _, issue := range issues {
 		for _, cf := range issue.CustomFields {
-			if cf.Name == "Estimation" {
+			if cf.Name == fieldName {
 				if cf.Value != nil {
 					// YouTrack API returns PeriodValue as a map with "presentation" key
 					if valMap, ok := cf.Value.(map[string]interface{}); ok {
@@ -426,7 +586,7 @@ func SumEstimation(issues []Issue) time.Duration {
 						}
 					}
 				}
-				break // Found Estimation, move to next issue
+				break // Found the field, move to next issue
 			}
 		}
 	}
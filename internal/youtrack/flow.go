@@ -0,0 +1,245 @@
+package youtrack
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+// IssueFlow holds the lead and cycle time of a resolved issue.
+type IssueFlow struct {
+	Issue    Issue
+	Created  time.Time
+	Started  time.Time // First move to the start state, zero if it never happened
+	Resolved time.Time
+}
+
+// LeadTime is the time from creation to resolution.
+func (f IssueFlow) LeadTime() time.Duration {
+	return f.Resolved.Sub(f.Created)
+}
+
+// CycleTime is the time from the first move to the start state to resolution,
+// and false when the issue never entered that state.
+func (f IssueFlow) CycleTime() (time.Duration, bool) {
+	if f.Started.IsZero() {
+		return 0, false
+	}
+	return f.Resolved.Sub(f.Started), true
+}
+
+// FlowPercentiles are the percentiles reported for lead and cycle times.
+var FlowPercentiles = []float64{50, 75, 85, 95}
+
+// FlowStats summarizes a set of durations.
+type FlowStats struct {
+	Count       int
+	Average     time.Duration
+	Percentiles []time.Duration // Indexed like FlowPercentiles
+	Max         time.Duration
+}
+
+// flowHistogramBounds are the upper bounds in days of the histogram buckets; the last bucket is open.
+var flowHistogramBounds = []float64{1, 2, 3, 5, 8, 13, 21}
+
+// BuildFlow computes lead and cycle times of the resolved issues. The cycle starts with the
+// first change of State to startState (e.g. "In Progress") and ends with the resolution.
+func BuildFlow(cfg config.Config, issues []Issue, startState string) ([]IssueFlow, error) {
+	var flows []IssueFlow
+	for _, iss := range issues {
+		if iss.Resolved == 0 {
+			continue
+		}
+		activities, err := FetchIssueActivities(cfg, iss.ID, "CustomFieldCategory")
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch activities of %s: %w", iss.ID, err)
+		}
+		flows = append(flows, issueFlow(iss, activities, startState))
+	}
+
+	sort.SliceStable(flows, func(i, j int) bool { return flows[i].Resolved.Before(flows[j].Resolved) })
+	return flows, nil
+}
+
+// issueFlow derives the flow timestamps of a resolved issue from its State changes.
+func issueFlow(iss Issue, activities []Activity, startState string) IssueFlow {
+	flow := IssueFlow{Issue: iss, Created: unixMilliToTime(iss.Created), Resolved: unixMilliToTime(iss.Resolved)}
+	for _, a := range fieldChanges(activities, "State") {
+		if strings.EqualFold(activityValue(a.Added), startState) {
+			flow.Started = unixMilliToTime(a.Timestamp)
+			break
+		}
+	}
+	return flow
+}
+
+// SummarizeFlow computes statistics of the lead and cycle times.
+func SummarizeFlow(flows []IssueFlow) (lead, cycle FlowStats) {
+	var leads, cycles []time.Duration
+	for _, f := range flows {
+		leads = append(leads, f.LeadTime())
+		if d, ok := f.CycleTime(); ok {
+			cycles = append(cycles, d)
+		}
+	}
+	return flowStats(leads), flowStats(cycles)
+}
+
+// flowStats computes the statistics of durations. durations is sorted in place.
+func flowStats(durations []time.Duration) FlowStats {
+	stats := FlowStats{Count: len(durations)}
+	if len(durations) == 0 {
+		return stats
+	}
+
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+	}
+	stats.Average = total / time.Duration(len(durations))
+	stats.Max = durations[len(durations)-1]
+	for _, p := range FlowPercentiles {
+		stats.Percentiles = append(stats.Percentiles, percentile(durations, p))
+	}
+	return stats
+}
+
+// percentile returns the nearest-rank percentile p of sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// formatDays formats a duration as calendar days, e.g. "3.5d".
+func formatDays(d time.Duration) string {
+	return fmt.Sprintf("%.1fd", d.Hours()/24)
+}
+
+// flowHistogram counts durations per bucket of flowHistogramBounds.
+func flowHistogram(durations []time.Duration) []int {
+	counts := make([]int, len(flowHistogramBounds)+1)
+	for _, d := range durations {
+		days := d.Hours() / 24
+		i := sort.SearchFloat64s(flowHistogramBounds, days)
+		// SearchFloat64s returns the first bound >= days; a value equal to a bound belongs to the next bucket
+		if i < len(flowHistogramBounds) && days == flowHistogramBounds[i] {
+			i++
+		}
+		counts[i]++
+	}
+	return counts
+}
+
+// flowHistogramLabel returns the label of histogram bucket i.
+func flowHistogramLabel(i int) string {
+	if i == 0 {
+		return fmt.Sprintf("< %gd", flowHistogramBounds[0])
+	}
+	if i == len(flowHistogramBounds) {
+		return fmt.Sprintf(">= %gd", flowHistogramBounds[i-1])
+	}
+	return fmt.Sprintf("%g-%gd", flowHistogramBounds[i-1], flowHistogramBounds[i])
+}
+
+// PrintFlow prints per-issue lead and cycle times, their percentiles and histograms.
+func PrintFlow(flows []IssueFlow, startState string) {
+	if len(flows) == 0 {
+		fmt.Println("No resolved issues found.")
+		return
+	}
+
+	fmt.Printf("%-12s\t%-10s\t%-10s\t%-8s\t%-8s\t%s\n", "ID", "Created", "Resolved", "Lead", "Cycle", "Title")
+	var leads, cycles []time.Duration
+	for _, f := range flows {
+		cycle := "N/A"
+		if d, ok := f.CycleTime(); ok {
+			cycle = formatDays(d)
+			cycles = append(cycles, d)
+		}
+		leads = append(leads, f.LeadTime())
+		fmt.Printf("%-12s\t%-10s\t%-10s\t%-8s\t%-8s\t%s\n", f.Issue.ID, f.Created.Format("2006-01-02"),
+			f.Resolved.Format("2006-01-02"), formatDays(f.LeadTime()), cycle, f.Issue.Summary)
+	}
+
+	lead, cycle := SummarizeFlow(flows)
+	fmt.Println()
+	fmt.Printf("%-12s\t%-5s\t%-8s", "", "Count", "Average")
+	for _, p := range FlowPercentiles {
+		fmt.Printf("\t%-8s", fmt.Sprintf("p%g", p))
+	}
+	fmt.Printf("\t%s\n", "Max")
+	printFlowStats("Lead time", lead)
+	printFlowStats("Cycle time", cycle)
+	if skipped := len(flows) - cycle.Count; skipped > 0 {
+		fmt.Printf("(%d issues never entered '%s' and have no cycle time)\n", skipped, startState)
+	}
+
+	printFlowHistogram("Lead time", leads)
+	printFlowHistogram("Cycle time", cycles)
+}
+
+// printFlowStats prints one row of the statistics table.
+func printFlowStats(name string, stats FlowStats) {
+	if stats.Count == 0 {
+		fmt.Printf("%-12s\t%-5d\tN/A\n", name, 0)
+		return
+	}
+	fmt.Printf("%-12s\t%-5d\t%-8s", name, stats.Count, formatDays(stats.Average))
+	for _, d := range stats.Percentiles {
+		fmt.Printf("\t%-8s", formatDays(d))
+	}
+	fmt.Printf("\t%s\n", formatDays(stats.Max))
+}
+
+// printFlowHistogram prints a horizontal bar histogram of durations.
+func printFlowHistogram(name string, durations []time.Duration) {
+	if len(durations) == 0 {
+		return
+	}
+
+	counts := flowHistogram(durations)
+	maxCount := 0
+	for _, c := range counts {
+		if c > maxCount {
+			maxCount = c
+		}
+	}
+
+	fmt.Printf("\n%s distribution:\n", name)
+	for i, c := range counts {
+		bar := strings.Repeat("█", int(math.Round(float64(c)/float64(maxCount)*30)))
+		fmt.Printf("  %-7s\t%4d  %s\n", flowHistogramLabel(i), c, bar)
+	}
+}
+
+// WriteFlowCSV writes per-issue flow data as CSV with times in decimal days.
+func WriteFlowCSV(w io.Writer, flows []IssueFlow) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"id", "summary", "created", "started", "resolved", "lead_days", "cycle_days"}); err != nil {
+		return err
+	}
+	for _, f := range flows {
+		started, cycle := "", ""
+		if d, ok := f.CycleTime(); ok {
+			started = f.Started.Format(time.RFC3339)
+			cycle = strconv.FormatFloat(d.Hours()/24, 'f', 2, 64)
+		}
+		record := []string{f.Issue.ID, f.Issue.Summary, f.Created.Format(time.RFC3339), started, f.Resolved.Format(time.RFC3339),
+			strconv.FormatFloat(f.LeadTime().Hours()/24, 'f', 2, 64), cycle}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
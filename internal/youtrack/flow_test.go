@@ -0,0 +1,74 @@
+package youtrack
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+const day = 24 * time.Hour
+
+func TestPercentile(t *testing.T) {
+	sorted := []time.Duration{1 * day, 2 * day, 3 * day, 4 * day, 5 * day, 6 * day, 7 * day, 8 * day, 9 * day, 10 * day}
+	tests := []struct {
+		durations []time.Duration
+		p         float64
+		want      time.Duration
+	}{
+		{sorted, 50, 5 * day},
+		{sorted, 75, 8 * day}, // Rank 7.5 rounds up
+		{sorted, 85, 9 * day},
+		{sorted, 95, 10 * day},
+		{sorted, 100, 10 * day},
+		{sorted, 0, 1 * day}, // Rank 0 clamps to the first value
+		{sorted, 1, 1 * day}, // Rank 0.1 rounds up to 1
+		{sorted[:1], 50, 1 * day},
+		{sorted[:1], 95, 1 * day},
+	}
+	for _, tt := range tests {
+		if got := percentile(tt.durations, tt.p); got != tt.want {
+			t.Errorf("percentile(%d values, %g) = %v, want %v", len(tt.durations), tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestFlowHistogram(t *testing.T) {
+	tests := []struct {
+		name      string
+		durations []time.Duration
+		want      []int
+	}{
+		{"empty", nil, []int{0, 0, 0, 0, 0, 0, 0, 0}},
+		{"below first bound", []time.Duration{0, 23 * time.Hour}, []int{2, 0, 0, 0, 0, 0, 0, 0}},
+		{"boundary goes to next bucket", []time.Duration{1 * day, 2 * day, 21 * day}, []int{0, 1, 1, 0, 0, 0, 0, 1}},
+		{"just below a bound", []time.Duration{5*day - time.Minute}, []int{0, 0, 0, 1, 0, 0, 0, 0}},
+		{"open last bucket", []time.Duration{100 * day}, []int{0, 0, 0, 0, 0, 0, 0, 1}},
+		{"mixed", []time.Duration{12 * time.Hour, 4 * day, 4 * day, 13 * day, 20 * day}, []int{1, 0, 0, 2, 0, 0, 2, 0}},
+	}
+	for _, tt := range tests {
+		if got := flowHistogram(tt.durations); !slices.Equal(got, tt.want) {
+			t.Errorf("flowHistogram(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFlowStats(t *testing.T) {
+	tests := []struct {
+		name      string
+		durations []time.Duration
+		want      FlowStats
+	}{
+		{"empty", nil, FlowStats{}},
+		{"single", []time.Duration{3 * day}, FlowStats{Count: 1, Average: 3 * day, Max: 3 * day,
+			Percentiles: []time.Duration{3 * day, 3 * day, 3 * day, 3 * day}}},
+		{"unsorted", []time.Duration{4 * day, 1 * day, 3 * day, 2 * day}, FlowStats{Count: 4, Average: 60 * time.Hour, Max: 4 * day,
+			Percentiles: []time.Duration{2 * day, 3 * day, 4 * day, 4 * day}}},
+	}
+	for _, tt := range tests {
+		got := flowStats(tt.durations)
+		if got.Count != tt.want.Count || got.Average != tt.want.Average || got.Max != tt.want.Max ||
+			!slices.Equal(got.Percentiles, tt.want.Percentiles) {
+			t.Errorf("flowStats(%s) = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,312 @@
+package youtrack
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+// issueIDPattern matches a readable issue ID such as "DP-123".
+var issueIDPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-\d+$`)
+
+// importColumns are the columns of an import file; date, issue and duration are required.
+var importColumns = []string{"date", "issue", "duration", "description", "type"}
+
+// Import row statuses.
+const (
+	ImportPending   = "pending"
+	ImportInvalid   = "invalid"
+	ImportDuplicate = "duplicate"
+	ImportAdded     = "added"
+	ImportQueued    = "queued" // YouTrack was unreachable, sent by 'youtrack-cli sync'
+	ImportFailed    = "failed"
+)
+
+// ImportRow is a single work item read from an import file together with its outcome.
+type ImportRow struct {
+	Line    int               // Line (CSV) or entry number (JSON) in the file
+	Fields  map[string]string // Raw values keyed by column
+	IssueID string
+	Item    NewWorkItem
+	Status  string
+	Message string
+}
+
+// ReadImportFile reads work items from a CSV file with a header row or a JSON array
+// of objects, both using the columns date, issue, duration, description and type.
+func ReadImportFile(path string) ([]ImportRow, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return readImportCSV(f)
+	case ".json":
+		return readImportJSON(f)
+	default:
+		return nil, fmt.Errorf("unsupported import file %s (use .csv or .json)", path)
+	}
+}
+
+// readImportCSV reads import rows from CSV, mapping columns by the header row.
+func readImportCSV(r io.Reader) ([]ImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range importColumns[:3] {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("CSV header is missing the '%s' column (expected %s)", required, strings.Join(importColumns, ","))
+		}
+	}
+
+	var rows []ImportRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		fields := make(map[string]string)
+		for _, name := range importColumns {
+			if i, ok := columns[name]; ok && i < len(record) {
+				fields[name] = strings.TrimSpace(record[i])
+			}
+		}
+		rows = append(rows, ImportRow{Line: line, Fields: fields, Status: ImportPending})
+	}
+	return rows, nil
+}
+
+// readImportJSON reads import rows from a JSON array; durations may be strings or minutes.
+func readImportJSON(r io.Reader) ([]ImportRow, error) {
+	var entries []map[string]interface{}
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	rows := make([]ImportRow, 0, len(entries))
+	for i, entry := range entries {
+		fields := make(map[string]string)
+		for key, value := range entry {
+			key = strings.ToLower(key)
+			switch v := value.(type) {
+			case nil:
+			case string:
+				fields[key] = strings.TrimSpace(v)
+			case float64:
+				fields[key] = strconv.FormatFloat(v, 'f', -1, 64)
+			default:
+				fields[key] = fmt.Sprint(v)
+			}
+		}
+		rows = append(rows, ImportRow{Line: i + 1, Fields: fields, Status: ImportPending})
+	}
+	return rows, nil
+}
+
+// ValidateImport parses and checks every row locally, marking invalid rows. The length
+// of a day is only fetched when some row uses days, and work item types are checked
+// against the time tracking settings of each issue's project. The warning is non-empty
+// when the day length had to fall back to the config.
+func ValidateImport(cfg config.Config, rows []ImportRow, now time.Time) (warning string) {
+	settings := newTimeTrackingCache(cfg)
+	hoursPerDay := cfg.Capacity.HoursPerDayFor("")
+	for _, row := range rows {
+		if DurationUsesDays(row.Fields["duration"]) {
+			hoursPerDay, warning = WorkDayHours(cfg)
+			break
+		}
+	}
+
+	for i := range rows {
+		if err := validateImportRow(&rows[i], settings, hoursPerDay, now); err != nil {
+			rows[i].Status = ImportInvalid
+			rows[i].Message = err.Error()
+		}
+	}
+	return warning
+}
+
+// validateImportRow fills in the issue and work item of a row from its raw fields.
+func validateImportRow(row *ImportRow, settings *timeTrackingCache, hoursPerDay float64, now time.Time) error {
+	row.IssueID = strings.ToUpper(row.Fields["issue"])
+	if row.IssueID == "" {
+		return fmt.Errorf("missing issue")
+	}
+	if !issueIDPattern.MatchString(row.IssueID) {
+		return fmt.Errorf("invalid issue ID '%s'", row.Fields["issue"])
+	}
+
+	if row.Fields["date"] == "" {
+		return fmt.Errorf("missing date")
+	}
+	date, err := ParseDate(row.Fields["date"], now)
+	if err != nil {
+		return err
+	}
+	if date.After(now) {
+		return fmt.Errorf("date %s is in the future", date.Format("2006-01-02"))
+	}
+
+	if row.Fields["duration"] == "" {
+		return fmt.Errorf("missing duration")
+	}
+	d, err := ParseDuration(row.Fields["duration"], hoursPerDay)
+	if err != nil {
+		return err
+	}
+
+	row.Item = NewWorkItem{Duration: d, Description: row.Fields["description"], Date: date}
+	if name := row.Fields["type"]; name != "" {
+		tracking, err := settings.forIssue(row.IssueID)
+		if err != nil {
+			return err
+		}
+		t, err := FindWorkItemType(tracking.WorkItemTypes, name)
+		if err != nil {
+			return err
+		}
+		row.Item.Type = &t
+	}
+	return nil
+}
+
+// MarkImportDuplicates marks valid rows that match an existing work item of the current
+// user, or an earlier row of the file, on issue, day, duration and description.
+func MarkImportDuplicates(cfg config.Config, rows []ImportRow) error {
+	var first, last time.Time
+	for _, row := range rows {
+		if row.Status != ImportPending {
+			continue
+		}
+		if first.IsZero() || row.Item.Date.Before(first) {
+			first = row.Item.Date
+		}
+		if last.IsZero() || row.Item.Date.After(last) {
+			last = row.Item.Date
+		}
+	}
+	if first.IsZero() {
+		return nil
+	}
+
+	user, err := CurrentUser(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to fetch current user: %w", err)
+	}
+	existing, err := FetchWorkItems(cfg, WorkItemFilter{Since: first, Until: last, Author: user.Login})
+	if err != nil {
+		return fmt.Errorf("failed to fetch existing work items: %w", err)
+	}
+
+	markDuplicates(rows, existing)
+	return nil
+}
+
+// markDuplicates marks pending rows matching one of the existing work items or an earlier row.
+func markDuplicates(rows []ImportRow, existing []WorkItem) {
+	seen := make(map[string]string)
+	for _, item := range existing {
+		seen[importKey(workItemIssueID(item), workItemDay(item), WorkItemDuration(item), item.Text)] = "already logged in YouTrack"
+	}
+	for i, row := range rows {
+		if row.Status != ImportPending {
+			continue
+		}
+		key := importKey(row.IssueID, row.Item.Date, row.Item.Duration, row.Item.Description)
+		if reason, ok := seen[key]; ok {
+			rows[i].Status = ImportDuplicate
+			rows[i].Message = reason
+			continue
+		}
+		seen[key] = fmt.Sprintf("same as line %d", row.Line)
+	}
+}
+
+// importKey identifies a work item for duplicate detection.
+func importKey(issueID string, day time.Time, d time.Duration, text string) string {
+	return fmt.Sprintf("%s|%s|%d|%s", strings.ToUpper(issueID), day.Format("2006-01-02"), int(d/time.Minute), strings.TrimSpace(text))
+}
+
+// RunImport adds every pending row with AddWorkItem, recording the outcome per row.
+func RunImport(cfg config.Config, rows []ImportRow) {
+	for i, row := range rows {
+		if row.Status != ImportPending {
+			continue
+		}
+		err := AddWorkItem(cfg, row.IssueID, row.Item)
+		if errors.Is(err, ErrQueued) {
+			rows[i].Status = ImportQueued
+			rows[i].Message = "run 'youtrack-cli sync' when back online"
+			continue
+		}
+		if err != nil {
+			rows[i].Status = ImportFailed
+			rows[i].Message = err.Error()
+			continue
+		}
+		rows[i].Status = ImportAdded
+	}
+}
+
+// PrintImportReport prints the outcome of every row followed by counts per status.
+// In a dry run pending rows are reported as "would add".
+func PrintImportReport(rows []ImportRow, dryRun bool) {
+	if len(rows) == 0 {
+		fmt.Println("No work items found in file.")
+		return
+	}
+
+	fmt.Printf("%-6s\t%-10s\t%-12s\t%-8s\t%-10s\t%s\n", "Line", "Date", "Issue", "Duration", "Result", "Details")
+	counts := make(map[string]int)
+	for _, row := range rows {
+		status := row.Status
+		if dryRun && status == ImportPending {
+			status = "would add"
+		}
+		counts[status]++
+
+		date, duration, details := row.Fields["date"], row.Fields["duration"], row.Message
+		if row.Status != ImportInvalid {
+			date = row.Item.Date.Format("2006-01-02")
+			duration = HumanizeDuration(row.Item.Duration)
+		}
+		if details == "" {
+			details = row.Item.Description
+		}
+		fmt.Printf("%-6d\t%-10s\t%-12s\t%-8s\t%-10s\t%s\n", row.Line, date, orNA(row.IssueID), duration, status, details)
+	}
+
+	var summary []string
+	for _, status := range []string{"would add", ImportAdded, ImportQueued, ImportDuplicate, ImportInvalid, ImportFailed} {
+		if counts[status] > 0 {
+			summary = append(summary, fmt.Sprintf("%d %s", counts[status], status))
+		}
+	}
+	fmt.Printf("\n%d rows: %s\n", len(rows), strings.Join(summary, ", "))
+}
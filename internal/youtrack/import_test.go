@@ -0,0 +1,186 @@
+package youtrack
+
+import (
+	"errors"
+	"maps"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadImport(t *testing.T) {
+	tests := []struct {
+		name    string
+		read    func(string) ([]ImportRow, error)
+		in      string
+		lines   []int
+		fields  []map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "csv",
+			read:  func(s string) ([]ImportRow, error) { return readImportCSV(strings.NewReader(s)) },
+			in:    "Date,Issue,Duration,Description\n2026-10-12, DP-1 ,1h30m,Review\n\n2026-10-13,DP-2,2h\n",
+			lines: []int{2, 4},
+			fields: []map[string]string{
+				{"date": "2026-10-12", "issue": "DP-1", "duration": "1h30m", "description": "Review"},
+				{"date": "2026-10-13", "issue": "DP-2", "duration": "2h"},
+			},
+		},
+		{
+			name:  "csv columns in any order",
+			read:  func(s string) ([]ImportRow, error) { return readImportCSV(strings.NewReader(s)) },
+			in:    "type,duration,issue,date\nDevelopment,30m,DP-1,today\n",
+			lines: []int{2},
+			fields: []map[string]string{
+				{"date": "today", "issue": "DP-1", "duration": "30m", "type": "Development"},
+			},
+		},
+		{
+			name:    "csv missing column",
+			read:    func(s string) ([]ImportRow, error) { return readImportCSV(strings.NewReader(s)) },
+			in:      "date,issue,description\n2026-10-12,DP-1,Review\n",
+			wantErr: true,
+		},
+		{
+			name:  "json",
+			read:  func(s string) ([]ImportRow, error) { return readImportJSON(strings.NewReader(s)) },
+			in:    `[{"Date": "2026-10-12", "issue": "DP-1", "duration": 90, "description": null}, {"date": "yesterday", "issue": "dp-2", "duration": "2h"}]`,
+			lines: []int{1, 2},
+			fields: []map[string]string{
+				{"date": "2026-10-12", "issue": "DP-1", "duration": "90"},
+				{"date": "yesterday", "issue": "dp-2", "duration": "2h"},
+			},
+		},
+		{
+			name:    "json not an array",
+			read:    func(s string) ([]ImportRow, error) { return readImportJSON(strings.NewReader(s)) },
+			in:      `{"date": "2026-10-12"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		rows, err := tt.read(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("read(%s) = %d rows, want error", tt.name, len(rows))
+			}
+			continue
+		}
+		if err != nil || len(rows) != len(tt.fields) {
+			t.Errorf("read(%s) = %d rows, %v, want %d rows", tt.name, len(rows), err, len(tt.fields))
+			continue
+		}
+		for i, row := range rows {
+			if row.Line != tt.lines[i] || row.Status != ImportPending || !maps.Equal(row.Fields, tt.fields[i]) {
+				t.Errorf("read(%s) row %d = line %d, %s, %v, want line %d, %s, %v", tt.name, i,
+					row.Line, row.Status, row.Fields, tt.lines[i], ImportPending, tt.fields[i])
+			}
+		}
+	}
+}
+
+func TestValidateImportRow(t *testing.T) {
+	now := time.Date(2026, 10, 14, 15, 0, 0, 0, time.Local)
+	settings := &timeTrackingCache{
+		settings: map[string]ProjectTimeTracking{"DP": {WorkItemTypes: []WorkItemType{{ID: "1-1", Name: "Development"}}}},
+		errs:     map[string]error{"GONE": errors.New("issue 'GONE-1' not found")},
+	}
+	tests := []struct {
+		name     string
+		fields   map[string]string
+		want     NewWorkItem
+		wantType string
+		wantErr  string
+	}{
+		{
+			name:   "valid",
+			fields: map[string]string{"date": "2026-10-12", "issue": "dp-1", "duration": "1h30m", "description": "Review"},
+			want:   NewWorkItem{Date: time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local), Duration: 90 * time.Minute, Description: "Review"},
+		},
+		{
+			name:   "days use the given day length",
+			fields: map[string]string{"date": "yesterday", "issue": "DP-1", "duration": "1d"},
+			want:   NewWorkItem{Date: time.Date(2026, 10, 13, 0, 0, 0, 0, time.Local), Duration: 8 * time.Hour},
+		},
+		{
+			name:     "type",
+			fields:   map[string]string{"date": "today", "issue": "DP-1", "duration": "30m", "type": "development"},
+			want:     NewWorkItem{Date: time.Date(2026, 10, 14, 0, 0, 0, 0, time.Local), Duration: 30 * time.Minute},
+			wantType: "Development",
+		},
+		{name: "missing issue", fields: map[string]string{"date": "today", "duration": "1h"}, wantErr: "missing issue"},
+		{name: "invalid issue", fields: map[string]string{"date": "today", "issue": "DP1", "duration": "1h"}, wantErr: "invalid issue ID"},
+		{name: "issue not found", fields: map[string]string{"date": "today", "issue": "GONE-1", "duration": "1h", "type": "Development"}, wantErr: "not found"},
+		{name: "missing date", fields: map[string]string{"issue": "DP-1", "duration": "1h"}, wantErr: "missing date"},
+		{name: "bad date", fields: map[string]string{"date": "12/10/2026", "issue": "DP-1", "duration": "1h"}, wantErr: "invalid date"},
+		{name: "future date", fields: map[string]string{"date": "2026-10-15", "issue": "DP-1", "duration": "1h"}, wantErr: "in the future"},
+		{name: "missing duration", fields: map[string]string{"date": "today", "issue": "DP-1"}, wantErr: "missing duration"},
+		{name: "bad duration", fields: map[string]string{"date": "today", "issue": "DP-1", "duration": "1x"}, wantErr: "invalid duration"},
+		{name: "unknown type", fields: map[string]string{"date": "today", "issue": "DP-1", "duration": "1h", "type": "Meeting"}, wantErr: "unknown work item type"},
+	}
+	for _, tt := range tests {
+		row := ImportRow{Fields: tt.fields, Status: ImportPending}
+		err := validateImportRow(&row, settings, 8, now)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateImportRow(%s) = %v, want error containing %q", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("validateImportRow(%s) = %v", tt.name, err)
+			continue
+		}
+		gotType := ""
+		if row.Item.Type != nil {
+			gotType = row.Item.Type.Name
+		}
+		if !row.Item.Date.Equal(tt.want.Date) || row.Item.Duration != tt.want.Duration ||
+			row.Item.Description != tt.want.Description || gotType != tt.wantType {
+			t.Errorf("validateImportRow(%s) = %+v (type %q), want %+v (type %q)", tt.name, row.Item, gotType, tt.want, tt.wantType)
+		}
+	}
+}
+
+func TestMarkDuplicates(t *testing.T) {
+	monday := time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local)
+	row := func(line int, issueID string, day int, d time.Duration, text string) ImportRow {
+		return ImportRow{Line: line, IssueID: issueID, Status: ImportPending,
+			Item: NewWorkItem{Date: monday.AddDate(0, 0, day), Duration: d, Description: text}}
+	}
+	existing := []WorkItem{{
+		Date:     monday.Add(9 * time.Hour).UnixMilli(), // Logged during the day, compared by day
+		Duration: Duration{Minutes: 60},
+		Text:     "Review",
+		Issue:    &Issue{ID: "DP-1"},
+	}}
+	invalid := row(7, "DP-1", 0, time.Hour, "Review")
+	invalid.Status = ImportInvalid
+
+	rows := []ImportRow{
+		row(2, "DP-1", 0, time.Hour, "Review"),   // Already in YouTrack
+		row(3, "dp-1", 0, time.Hour, " Review "), // Same, spelled differently
+		row(4, "DP-1", 1, time.Hour, "Review"),   // Another day
+		row(5, "DP-1", 1, time.Hour, "Review"),   // Repeats line 4
+		row(6, "DP-1", 1, 2*time.Hour, "Review"), // Another duration
+		invalid,                                  // Left alone
+		row(8, "DP-2", 0, time.Hour, "Review"),   // Another issue
+	}
+	markDuplicates(rows, existing)
+
+	want := []struct{ status, message string }{
+		{ImportDuplicate, "already logged in YouTrack"},
+		{ImportDuplicate, "already logged in YouTrack"},
+		{ImportPending, ""},
+		{ImportDuplicate, "same as line 4"},
+		{ImportPending, ""},
+		{ImportInvalid, ""},
+		{ImportPending, ""},
+	}
+	for i, r := range rows {
+		if r.Status != want[i].status || r.Message != want[i].message {
+			t.Errorf("markDuplicates line %d = %s %q, want %s %q", r.Line, r.Status, r.Message, want[i].status, want[i].message)
+		}
+	}
+}
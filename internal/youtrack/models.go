@@ -6,8 +6,23 @@ package youtrack
 type Issue struct {
 	ID           string        `json:"idReadable"`
 	Summary      string        `json:"summary"`
+	Description  string        `json:"description,omitempty"`
+	Created      int64         `json:"created,omitempty"`  // Unix timestamp in milliseconds
+	Resolved     int64         `json:"resolved,omitempty"` // Unix timestamp in milliseconds, 0 while unresolved
 	CustomFields []CustomField `json:"customFields"`
 	Sprints      []Sprint      `json:"sprints,omitempty"` // Populated by separate API call
+	Parent       *IssueParent  `json:"parent,omitempty"`
+}
+
+// IssueParent is the "subtask of" link of an issue; Issues is empty for top-level issues.
+type IssueParent struct {
+	Issues []IssueRef `json:"issues"`
+}
+
+// IssueRef identifies a linked issue.
+type IssueRef struct {
+	ID      string `json:"idReadable"`
+	Summary string `json:"summary"`
 }
 
 type CustomField struct {
@@ -16,31 +31,163 @@ type CustomField struct {
 }
 
 type AgileBoard struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	CurrentSprint    *Sprint           `json:"currentSprint,omitempty"`    // Sprint marked as current on the board
+	ColumnSettings   *ColumnSettings   `json:"columnSettings,omitempty"`   // Populated by FetchBoardDetails
+	SwimlaneSettings *SwimlaneSettings `json:"swimlaneSettings,omitempty"` // Populated by FetchBoardDetails
+	SprintsSettings  *SprintsSettings  `json:"sprintsSettings,omitempty"`  // Populated by FetchBoardDetails
+
+	EstimationField         *FieldRef `json:"estimationField,omitempty"`
+	OriginalEstimationField *FieldRef `json:"originalEstimationField,omitempty"`
+	Projects                []Project `json:"projects,omitempty"`
+}
+
+type Project struct {
+	ID        string `json:"id,omitempty"`
+	ShortName string `json:"shortName"`
+	Name      string `json:"name"`
+}
+
+// SprintsSettings describes how issues are assigned to the sprints of a board.
+type SprintsSettings struct {
+	DisableSprints       bool      `json:"disableSprints"`
+	IsExplicit           bool      `json:"isExplicit"`                // Issues are added to sprints manually
+	SprintSyncField      *FieldRef `json:"sprintSyncField,omitempty"` // Field whose values define sprints when not explicit
+	CardOnSeveralSprints bool      `json:"cardOnSeveralSprints"`
 }
 
 type Sprint struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
 	// Add other relevant sprint fields if needed for sorting/filtering
-	Start  int64  `json:"start"`  // 新增：Sprint 開始時間 (Unix timestamp in milliseconds)
-	Finish int64  `json:"finish"` // 新增：Sprint 結束時間 (Unix timestamp in milliseconds)
-	// IsArchived bool `json:"archived"`
-	// IsCurrent  bool `json:"isCurrent"` // YouTrack API might have this
+	Start      int64  `json:"start"`  // 新增：Sprint 開始時間 (Unix timestamp in milliseconds)
+	Finish     int64  `json:"finish"` // 新增：Sprint 結束時間 (Unix timestamp in milliseconds)
+	IsArchived bool   `json:"archived"`
+	IsCurrent  bool   `json:"isCurrent"`
+	Goal       string `json:"goal,omitempty"`
 }
 
 type WorkItem struct {
-	Date     int64    `json:"date"`
-	Duration Duration `json:"duration"`
-	Author   Author   `json:"author"`
-	Text     string   `json:"text"`
+	ID         string              `json:"id,omitempty"`
+	Date       int64               `json:"date"`
+	Duration   Duration            `json:"duration"`
+	Author     Author              `json:"author"`
+	Text       string              `json:"text"`
+	Type       *WorkItemType       `json:"type,omitempty"`
+	Attributes []WorkItemAttribute `json:"attributes,omitempty"`
+	Issue      *Issue              `json:"issue,omitempty"` // Populated when fetched via /api/workItems
 }
 
 type Duration struct {
-	Minutes int `json:"minutes"`
+	Minutes      int    `json:"minutes"`
+	Presentation string `json:"presentation,omitempty"`
+}
+
+type WorkItemType struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// WorkItemAttribute is a custom attribute set on a work item, e.g. Billing: Client A.
+type WorkItemAttribute struct {
+	ID    string                  `json:"id,omitempty"`
+	Name  string                  `json:"name,omitempty"`
+	Value *WorkItemAttributeValue `json:"value,omitempty"`
+}
+
+// WorkItemAttributeValue is one of the predefined values of a work item attribute.
+type WorkItemAttributeValue struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// WorkItemAttributeDef is a work item attribute defined in a project with its allowed values.
+type WorkItemAttributeDef struct {
+	ID     string                   `json:"id"`
+	Name   string                   `json:"name"`
+	Values []WorkItemAttributeValue `json:"values"`
+}
+
+// ProjectTimeTracking holds the time tracking settings of a project.
+type ProjectTimeTracking struct {
+	Enabled       bool                   `json:"enabled"`
+	WorkItemTypes []WorkItemType         `json:"workItemTypes"`
+	Attributes    []WorkItemAttributeDef `json:"attributes"`
+}
+
+type User struct {
+	ID       string `json:"id"`
+	Login    string `json:"login"`
+	FullName string `json:"fullName"`
 }
 
 type Author struct {
-	Login string `json:"login"`
+	Login    string `json:"login"`
+	FullName string `json:"fullName,omitempty"`
+}
+
+// Comment is a comment on an issue.
+type Comment struct {
+	ID      string `json:"id"`
+	Text    string `json:"text"`
+	Created int64  `json:"created"` // Unix timestamp in milliseconds
+	Author  Author `json:"author"`
+}
+
+// Activity is a single entry of an issue's change history.
+type Activity struct {
+	Timestamp int64       `json:"timestamp"` // Unix timestamp in milliseconds
+	Author    Author      `json:"author"`
+	Category  *Category   `json:"category,omitempty"`
+	Field     *FieldRef   `json:"field,omitempty"`
+	Added     interface{} `json:"added"`
+	Removed   interface{} `json:"removed"`
+	Target    *IssueRef   `json:"target,omitempty"` // Changed issue, only fetched by fetchActivities
+}
+
+// Category identifies the kind of an activity, e.g. "CustomFieldCategory" or "SprintCategory".
+type Category struct {
+	ID string `json:"id"`
+}
+
+// FieldRef refers to a custom field by name.
+type FieldRef struct {
+	Name string `json:"name"`
+}
+
+// FieldValue is a value of an enum/state/user custom field.
+type FieldValue struct {
+	Name         string `json:"name"`
+	Presentation string `json:"presentation,omitempty"`
+}
+
+// ColumnSettings describes which field values map to each board column.
+type ColumnSettings struct {
+	Field   *FieldRef     `json:"field,omitempty"`
+	Columns []BoardColumn `json:"columns"`
+}
+
+type BoardColumn struct {
+	Presentation string       `json:"presentation"`
+	IsResolved   bool         `json:"isResolved"`
+	Ordinal      int          `json:"ordinal"`
+	WIPLimit     *WIPLimit    `json:"wipLimit,omitempty"`
+	FieldValues  []FieldValue `json:"fieldValues"`
+}
+
+// WIPLimit is the work in progress limit of a column; nil bounds are unset.
+type WIPLimit struct {
+	Min *int `json:"min"`
+	Max *int `json:"max"`
+}
+
+// SwimlaneSettings describes how a board splits issues into swimlanes.
+// Attribute based swimlanes group by the values of Field. Issue based swimlanes group cards under
+// their parent issue; Field and Values then select the issues that act as swimlanes.
+type SwimlaneSettings struct {
+	Type    string       `json:"$type"`
+	Enabled bool         `json:"enabled"`
+	Field   *FieldRef    `json:"field,omitempty"`
+	Values  []FieldValue `json:"values,omitempty"`
 }
@@ -0,0 +1,455 @@
+package youtrack
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+// ErrQueued is returned by modifying calls when YouTrack could not be reached and the change
+// was queued in the outbox for 'youtrack-cli sync' instead.
+var ErrQueued = errors.New("YouTrack is unreachable, the change was queued; run 'youtrack-cli sync' when back online")
+
+// Operation is a modifying request queued in the outbox because YouTrack could not be reached.
+type Operation struct {
+	Key       string          `json:"key"` // Identifies the operation in the outbox
+	Method    string          `json:"method"`
+	Path      string          `json:"path"`
+	Body      json.RawMessage `json:"body,omitempty"`
+	Summary   string          `json:"summary"`
+	QueuedAt  time.Time       `json:"queued_at"`
+	Attempts  int             `json:"attempts"`
+	LastError string          `json:"last_error,omitempty"`
+	// PossiblySent is set when a replay failed after the request may have reached YouTrack
+	PossiblySent bool            `json:"possibly_sent,omitempty"`
+	WorkItem     *QueuedWorkItem `json:"work_item,omitempty"`
+}
+
+// QueuedWorkItem describes a queued work item so that sync can tell whether an attempt
+// that possibly reached YouTrack created it. YouTrack cannot store a key of ours with a work
+// item, so its content is the key: issue, day, duration, text, type and attributes.
+type QueuedWorkItem struct {
+	IssueID    string            `json:"issue_id"`
+	Date       time.Time         `json:"date"`
+	Minutes    int               `json:"minutes"`
+	Text       string            `json:"text"`
+	Type       string            `json:"type,omitempty"`       // Work item type name
+	Attributes map[string]string `json:"attributes,omitempty"` // Attribute values keyed by attribute name
+	// Existing counts the matching work items found in YouTrack before the first replay, so
+	// that an identical work item logged earlier is not mistaken for this one
+	Existing *int `json:"existing,omitempty"`
+}
+
+// key identifies the content of a queued work item.
+func (wi QueuedWorkItem) key() string {
+	return workItemKey(wi.IssueID, startOfDay(wi.Date), time.Duration(wi.Minutes)*time.Minute, wi.Text, wi.Type, wi.Attributes)
+}
+
+// workItemKey identifies a work item by its content, ignoring case of the type and attributes.
+func workItemKey(issueID string, day time.Time, d time.Duration, text, typeName string, attrs map[string]string) string {
+	pairs := make([]string, 0, len(attrs))
+	for name, value := range attrs {
+		pairs = append(pairs, strings.ToLower(name+"="+value))
+	}
+	sort.Strings(pairs)
+	return fmt.Sprintf("%s|%s|%s", importKey(issueID, day, d, text), strings.ToLower(typeName), strings.Join(pairs, ","))
+}
+
+// SyncResult reports the outcome of replaying the outbox.
+type SyncResult struct {
+	Sent      []Operation
+	Skipped   []Operation // Possibly sent operations found applied in YouTrack
+	Failed    *Operation  // Operation that stopped the replay, nil when the outbox was emptied
+	Held      *Operation  // Possibly sent operation the user chose not to send again
+	Remaining int
+}
+
+// isConnectionError reports whether err means no connection to YouTrack could be opened,
+// so the request certainly was not applied.
+func isConnectionError(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// isTransportError reports whether err occurred while talking to YouTrack rather than
+// being an error response. Unless it is a connection error, the request may have been applied.
+func isTransportError(err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// send performs a modifying request without queueing it. A failure after the request may
+// have reached YouTrack says so, since retrying blindly could apply the change twice.
+func (c *Client) send(method, path string, body interface{}) error {
+	err := c.do(method, path, body, nil)
+	if err != nil && isTransportError(err) && !isConnectionError(err) {
+		return fmt.Errorf("%w (the change may have been applied, check YouTrack before retrying)", err)
+	}
+	return err
+}
+
+// mutate performs a modifying request. When no connection to YouTrack can be opened the
+// request is queued in the outbox for 'youtrack-cli sync' and ErrQueued is returned.
+// Other failures, including timeouts, are returned as they are. Only requests that sync
+// can replay safely are queued: work items to add, which are matched by content, and
+// updates and deletions of work items, which have the same effect when applied twice.
+func (c *Client) mutate(method, path string, body interface{}, summary string, workItem *QueuedWorkItem) error {
+	err := c.send(method, path, body)
+	if err == nil || !isConnectionError(err) {
+		return err
+	}
+
+	key, kerr := newOperationKey()
+	if kerr != nil {
+		return fmt.Errorf("%v (queueing it failed too: %v)", err, kerr)
+	}
+	op := Operation{Key: key, Method: method, Path: path, Summary: summary, QueuedAt: time.Now(), Attempts: 1,
+		LastError: err.Error(), WorkItem: workItem}
+	if body != nil {
+		if op.Body, kerr = json.Marshal(body); kerr != nil {
+			return fmt.Errorf("failed to marshal request body: %w", kerr)
+		}
+	}
+	if qerr := enqueue(op); qerr != nil {
+		return fmt.Errorf("%v (queueing it failed too: %v)", err, qerr)
+	}
+	return ErrQueued
+}
+
+// newOperationKey returns a random key identifying a single queued operation.
+func newOperationKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate operation key: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// outboxPath returns the path of the outbox file.
+func outboxPath() (string, error) {
+	dir, err := config.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "outbox.json"), nil
+}
+
+// Lock timings: how long to wait for another process, and after how long a lock left behind by
+// a crashed process is removed. A running sync refreshes its lock after every operation.
+var (
+	outboxLockWait  = 10 * time.Second
+	outboxLockStale = 2 * time.Minute
+)
+
+// outboxLock is a lock file serializing changes to the outbox between processes.
+type outboxLock struct {
+	path string
+}
+
+// lockOutbox creates the outbox lock file, waiting while another process holds it.
+func lockOutbox() (*outboxLock, error) {
+	path, err := outboxPath()
+	if err != nil {
+		return nil, err
+	}
+	path += ".lock"
+
+	deadline := time.Now().Add(outboxLockWait)
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			fmt.Fprintf(f, "%d\n", os.Getpid())
+			f.Close()
+			return &outboxLock{path: path}, nil
+		}
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("failed to lock outbox: %w", err)
+		}
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > outboxLockStale {
+			os.Remove(path)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("outbox is locked by another youtrack-cli process (remove %s if none is running)", path)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// refresh marks the lock as still in use.
+func (l *outboxLock) refresh() {
+	now := time.Now()
+	os.Chtimes(l.path, now, now)
+}
+
+// release removes the lock file.
+func (l *outboxLock) release() {
+	os.Remove(l.path)
+}
+
+// PendingOperations returns the queued operations, oldest first.
+func PendingOperations() ([]Operation, error) {
+	path, err := outboxPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read outbox: %w", err)
+	}
+
+	var ops []Operation
+	if err := json.Unmarshal(data, &ops); err != nil {
+		return nil, fmt.Errorf("failed to parse outbox %s: %w", path, err)
+	}
+	return ops, nil
+}
+
+// saveOutbox replaces the outbox atomically so that a crash never leaves it half written.
+func saveOutbox(ops []Operation) error {
+	path, err := outboxPath()
+	if err != nil {
+		return err
+	}
+	if len(ops) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove outbox: %w", err)
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(ops, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal outbox: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write outbox: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write outbox: %w", err)
+	}
+	return nil
+}
+
+// enqueue appends an operation to the outbox.
+func enqueue(op Operation) error {
+	lock, err := lockOutbox()
+	if err != nil {
+		return err
+	}
+	defer lock.release()
+
+	ops, err := PendingOperations()
+	if err != nil {
+		return err
+	}
+	return saveOutbox(append(ops, op))
+}
+
+// DropOperation removes a queued operation by its key or a unique prefix of it.
+func DropOperation(key string) (Operation, error) {
+	lock, err := lockOutbox()
+	if err != nil {
+		return Operation{}, err
+	}
+	defer lock.release()
+
+	ops, err := PendingOperations()
+	if err != nil {
+		return Operation{}, err
+	}
+
+	match := -1
+	for i, op := range ops {
+		if len(key) > 0 && len(key) <= len(op.Key) && op.Key[:len(key)] == key {
+			if match >= 0 {
+				return Operation{}, fmt.Errorf("key '%s' matches several operations", key)
+			}
+			match = i
+		}
+	}
+	if match < 0 {
+		return Operation{}, fmt.Errorf("no queued operation with key '%s'", key)
+	}
+
+	dropped := ops[match]
+	return dropped, saveOutbox(append(ops[:match], ops[match+1:]...))
+}
+
+// SyncOutbox replays the queued operations in order. Each operation is removed from the
+// outbox as soon as it succeeds; the replay stops at the first failure so that later
+// operations never overtake earlier ones. A possibly sent operation is checked in YouTrack
+// first: a work item is only added again when YouTrack has no more matching work items than
+// before the first replay, and a deletion is skipped once the work item is gone. Updates are
+// sent again, and for anything else, such as comments queued by earlier versions, confirm decides.
+func SyncOutbox(cfg config.Config, confirm func(Operation) bool) (SyncResult, error) {
+	var result SyncResult
+	lock, err := lockOutbox()
+	if err != nil {
+		return result, err
+	}
+	defer lock.release()
+
+	ops, err := PendingOperations()
+	if err != nil {
+		return result, err
+	}
+
+	client := NewClient(cfg)
+	var login string
+	for len(ops) > 0 {
+		op := ops[0]
+
+		applied := false
+		switch {
+		case op.WorkItem != nil:
+			if login == "" {
+				user, err := CurrentUser(cfg)
+				if err != nil {
+					result.Remaining = len(ops)
+					return result, fmt.Errorf("failed to check whether '%s' was applied: %w", op.Summary, err)
+				}
+				login = user.Login
+			}
+			count, err := countWorkItems(cfg, login, *op.WorkItem)
+			if err != nil {
+				result.Remaining = len(ops)
+				return result, fmt.Errorf("failed to check whether '%s' was applied: %w", op.Summary, err)
+			}
+			switch {
+			case op.WorkItem.Existing != nil:
+				applied = op.PossiblySent && count > *op.WorkItem.Existing
+			case op.PossiblySent:
+				// Queued by an earlier version that did not count the existing work items
+				applied = count > 0
+			default:
+				// Remember what was there before the request is sent for the first time
+				ops[0].WorkItem.Existing = &count
+				if err := saveOutbox(ops); err != nil {
+					result.Remaining = len(ops)
+					return result, err
+				}
+			}
+		case op.PossiblySent && op.Method == "DELETE":
+			err := client.get(op.Path+"?fields=id", nil)
+			var apiErr *apiError
+			if err != nil && !(errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound) {
+				result.Remaining = len(ops)
+				return result, fmt.Errorf("failed to check whether '%s' was applied: %w", op.Summary, err)
+			}
+			applied = err != nil
+		case op.PossiblySent && !isWorkItemUpdate(op) && !confirm(op):
+			result.Held = &ops[0]
+			result.Remaining = len(ops)
+			return result, nil
+		}
+
+		if applied {
+			result.Skipped = append(result.Skipped, op)
+		} else {
+			var body interface{}
+			if len(op.Body) > 0 {
+				body = op.Body
+			}
+			if err := client.do(op.Method, op.Path, body, nil); err != nil {
+				ops[0].Attempts++
+				ops[0].LastError = err.Error()
+				if isTransportError(err) && !isConnectionError(err) {
+					ops[0].PossiblySent = true
+				}
+				result.Failed = &ops[0]
+				result.Remaining = len(ops)
+				return result, saveOutbox(ops)
+			}
+			result.Sent = append(result.Sent, op)
+		}
+
+		ops = ops[1:]
+		if err := saveOutbox(ops); err != nil {
+			result.Remaining = len(ops)
+			return result, err
+		}
+		lock.refresh()
+	}
+	return result, nil
+}
+
+// isWorkItemUpdate reports whether op changes an existing work item, which has the same
+// effect when sent twice.
+func isWorkItemUpdate(op Operation) bool {
+	return op.Method == "POST" && strings.Contains(op.Path, "/timeTracking/workItems/")
+}
+
+// countWorkItems counts the user's work items matching a queued one.
+func countWorkItems(cfg config.Config, login string, wi QueuedWorkItem) (int, error) {
+	items, err := FetchWorkItems(cfg, WorkItemFilter{
+		Query:  "issue id: " + wi.IssueID,
+		Since:  wi.Date,
+		Until:  wi.Date,
+		Author: login,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	want := wi.key()
+	count := 0
+	for _, item := range items {
+		attrs := make(map[string]string)
+		for _, attr := range item.Attributes {
+			if attr.Value != nil {
+				attrs[attr.Name] = attr.Value.Name
+			}
+		}
+		typeName := ""
+		if item.Type != nil {
+			typeName = item.Type.Name
+		}
+		if workItemKey(workItemIssueID(item), workItemDay(item), WorkItemDuration(item), item.Text, typeName, attrs) == want {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// PrintOutbox prints the queued operations.
+func PrintOutbox(ops []Operation) {
+	if len(ops) == 0 {
+		fmt.Println("No pending operations.")
+		return
+	}
+
+	fmt.Printf("%d pending operations:\n", len(ops))
+	fmt.Printf("%-8s\t%-16s\t%-8s\t%s\n", "Key", "Queued", "Attempts", "Operation")
+	for _, op := range ops {
+		summary := op.Summary
+		if op.PossiblySent {
+			summary += " (possibly sent)"
+		}
+		fmt.Printf("%-8s\t%-16s\t%-8d\t%s\n", op.Key[:8], op.QueuedAt.Format("2006-01-02 15:04"), op.Attempts, summary)
+		if op.LastError != "" {
+			fmt.Printf("        \tlast error: %s\n", op.LastError)
+		}
+	}
+}
@@ -0,0 +1,308 @@
+package youtrack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"slices"
+	"syscall"
+	"testing"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+func TestIsConnectionError(t *testing.T) {
+	dial := &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"connection refused", &url.Error{Op: "Post", URL: "https://yt", Err: dial}, true},
+		{"dns", &url.Error{Op: "Post", URL: "https://yt", Err: &net.DNSError{Err: "no such host", Name: "yt"}}, true},
+		{"wrapped", fmt.Errorf("failed to execute request: %w", &url.Error{Op: "Post", URL: "https://yt", Err: dial}), true},
+		{"timeout", &url.Error{Op: "Post", URL: "https://yt", Err: context.DeadlineExceeded}, false},
+		{"reset while reading", &url.Error{Op: "Post", URL: "https://yt", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}, false},
+		{"tls", &url.Error{Op: "Post", URL: "https://yt", Err: errors.New("tls: failed to verify certificate")}, false},
+		{"api error", errors.New("API request failed with status 400"), false},
+	}
+	for _, tt := range tests {
+		if got := isConnectionError(tt.err); got != tt.want {
+			t.Errorf("isConnectionError(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// fakeYouTrack serves the requests replayed by sync, recording them in order.
+type fakeYouTrack struct {
+	requests []string       // "METHOD path" without the query
+	fail     map[string]int // Status to answer per "METHOD path"
+	items    []WorkItem     // Returned by /api/workItems
+}
+
+func (f *fakeYouTrack) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request := r.Method + " " + r.URL.Path
+	f.requests = append(f.requests, request)
+	if status, ok := f.fail[request]; ok {
+		http.Error(w, "{}", status)
+		return
+	}
+	switch r.URL.Path {
+	case "/api/users/me":
+		json.NewEncoder(w).Encode(User{Login: "jane"})
+	case "/api/workItems":
+		json.NewEncoder(w).Encode(f.items)
+	default:
+		w.Write([]byte("{}"))
+	}
+}
+
+// sent returns the recorded requests other than the lookups sync makes.
+func (f *fakeYouTrack) sent() []string {
+	var sent []string
+	for _, r := range f.requests {
+		if r != "GET /api/users/me" && r != "GET /api/workItems" {
+			sent = append(sent, r)
+		}
+	}
+	return sent
+}
+
+// queueOps writes operations to the outbox, keyed by their position.
+func queueOps(t *testing.T, ops ...Operation) {
+	t.Helper()
+	for i := range ops {
+		ops[i].Key = fmt.Sprintf("%08d", i+1)
+		if err := enqueue(ops[i]); err != nil {
+			t.Fatalf("enqueue: %v", err)
+		}
+	}
+}
+
+func TestEnqueue(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	queueOps(t,
+		Operation{Method: "DELETE", Path: "/api/issues/DP-1/timeTracking/workItems/1", Summary: "first"},
+		Operation{Method: "DELETE", Path: "/api/issues/DP-1/timeTracking/workItems/2", Summary: "second"},
+		Operation{Method: "DELETE", Path: "/api/issues/DP-1/timeTracking/workItems/3", Summary: "third"},
+	)
+
+	ops, err := PendingOperations()
+	if err != nil || len(ops) != 3 || ops[0].Summary != "first" || ops[2].Summary != "third" {
+		t.Fatalf("PendingOperations() = %v, %v, want first, second, third", ops, err)
+	}
+	if _, err := DropOperation("0000"); err == nil {
+		t.Error("DropOperation accepted a prefix matching several operations")
+	}
+	if op, err := DropOperation("00000002"); err != nil || op.Summary != "second" {
+		t.Errorf("DropOperation(00000002) = %v, %v, want second", op.Summary, err)
+	}
+	if ops, _ := PendingOperations(); len(ops) != 2 || ops[1].Summary != "third" {
+		t.Errorf("after drop: %v, want first, third", ops)
+	}
+}
+
+func TestMutateQueuesOnlyWorkItems(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	// A port nothing listens on refuses the connection
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := config.Config{URL: "http://" + l.Addr().String(), Token: "t"}
+	l.Close()
+
+	item := NewWorkItem{Duration: time.Hour, Description: "Review", Date: time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local),
+		Type:       &WorkItemType{ID: "t1", Name: "Development"},
+		Attributes: []WorkItemAttribute{{ID: "a1", Name: "Billable", Value: &WorkItemAttributeValue{ID: "v1", Name: "Yes"}}}}
+	if err := AddWorkItem(cfg, "DP-1", item); !errors.Is(err, ErrQueued) {
+		t.Fatalf("AddWorkItem = %v, want ErrQueued", err)
+	}
+	if err := AddComment(cfg, "DP-1", "Done"); err == nil || errors.Is(err, ErrQueued) {
+		t.Errorf("AddComment = %v, want an error without queueing", err)
+	}
+	if err := SetIssueState(cfg, "DP-1", "Done"); err == nil || errors.Is(err, ErrQueued) {
+		t.Errorf("SetIssueState = %v, want an error without queueing", err)
+	}
+
+	ops, _ := PendingOperations()
+	if len(ops) != 1 || ops[0].WorkItem == nil {
+		t.Fatalf("queued %v, want the work item only", ops)
+	}
+	wi := ops[0].WorkItem
+	if wi.IssueID != "DP-1" || wi.Minutes != 60 || wi.Text != "Review" || wi.Type != "Development" || wi.Attributes["Billable"] != "Yes" {
+		t.Errorf("queued work item = %+v", *wi)
+	}
+}
+
+func TestSyncOutbox(t *testing.T) {
+	day := time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local)
+	update := func(id string) Operation {
+		return Operation{Method: "POST", Path: "/api/issues/DP-1/timeTracking/workItems/" + id, Body: json.RawMessage(`{"text":"x"}`), Summary: "update " + id}
+	}
+	deletion := Operation{Method: "DELETE", Path: "/api/issues/DP-1/timeTracking/workItems/9", Summary: "delete 9", PossiblySent: true}
+	comment := Operation{Method: "POST", Path: "/api/issues/DP-1/comments", Summary: "comment", PossiblySent: true}
+	add := func(existing *int, possiblySent bool) Operation {
+		return Operation{Method: "POST", Path: "/api/issues/DP-1/timeTracking/workItems", Summary: "add", PossiblySent: possiblySent,
+			WorkItem: &QueuedWorkItem{IssueID: "DP-1", Date: day, Minutes: 60, Text: "Review", Type: "Development", Existing: existing}}
+	}
+	logged := WorkItem{Date: day.Add(12 * time.Hour).UnixMilli(), Duration: Duration{Minutes: 60}, Text: "Review",
+		Type: &WorkItemType{Name: "development"}, Issue: &Issue{ID: "DP-1"}}
+	zero, one := 0, 1
+
+	tests := []struct {
+		name         string
+		ops          []Operation
+		fail         map[string]int
+		items        []WorkItem
+		sent         []string // Requests that modify YouTrack, in order
+		skipped      int
+		failed, held string
+		pending      []string // Summaries left in the outbox
+		existing     int      // Matching work items recorded for the first pending operation, if not 0
+	}{
+		{
+			name: "ordered replay",
+			ops:  []Operation{update("1"), update("2"), update("3")},
+			sent: []string{
+				"POST /api/issues/DP-1/timeTracking/workItems/1",
+				"POST /api/issues/DP-1/timeTracking/workItems/2",
+				"POST /api/issues/DP-1/timeTracking/workItems/3",
+			},
+		},
+		{
+			name: "stops on failure",
+			ops:  []Operation{update("1"), update("2"), update("3")},
+			fail: map[string]int{"POST /api/issues/DP-1/timeTracking/workItems/2": http.StatusBadRequest},
+			sent: []string{
+				"POST /api/issues/DP-1/timeTracking/workItems/1",
+				"POST /api/issues/DP-1/timeTracking/workItems/2",
+			},
+			failed:  "update 2",
+			pending: []string{"update 2", "update 3"},
+		},
+		{
+			name:    "possibly sent work item found",
+			ops:     []Operation{add(&zero, true), update("1")},
+			items:   []WorkItem{logged},
+			sent:    []string{"POST /api/issues/DP-1/timeTracking/workItems/1"},
+			skipped: 1,
+		},
+		{
+			name:  "possibly sent work item matching one logged before",
+			ops:   []Operation{add(&one, true)},
+			items: []WorkItem{logged},
+			sent:  []string{"POST /api/issues/DP-1/timeTracking/workItems"},
+		},
+		{
+			name:     "first replay counts existing work items",
+			ops:      []Operation{add(nil, false)},
+			items:    []WorkItem{logged},
+			fail:     map[string]int{"POST /api/issues/DP-1/timeTracking/workItems": http.StatusInternalServerError},
+			sent:     []string{"POST /api/issues/DP-1/timeTracking/workItems"},
+			failed:   "add",
+			pending:  []string{"add"},
+			existing: 1,
+		},
+		{
+			name:    "possibly sent deletion already applied",
+			ops:     []Operation{deletion},
+			fail:    map[string]int{"GET /api/issues/DP-1/timeTracking/workItems/9": http.StatusNotFound},
+			sent:    []string{"GET /api/issues/DP-1/timeTracking/workItems/9"},
+			skipped: 1,
+		},
+		{
+			name:    "possibly sent comment held",
+			ops:     []Operation{comment, update("1")},
+			held:    "comment",
+			pending: []string{"comment", "update 1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Setenv("HOME", t.TempDir())
+		fake := &fakeYouTrack{fail: tt.fail, items: tt.items}
+		srv := httptest.NewServer(fake)
+		queueOps(t, tt.ops...)
+
+		result, err := SyncOutbox(config.Config{URL: srv.URL, Token: "t"}, func(Operation) bool { return false })
+		srv.Close()
+		if err != nil {
+			t.Errorf("%s: SyncOutbox = %v", tt.name, err)
+			continue
+		}
+		if !slices.Equal(fake.sent(), tt.sent) {
+			t.Errorf("%s: requests = %v, want %v", tt.name, fake.sent(), tt.sent)
+		}
+		if len(result.Skipped) != tt.skipped {
+			t.Errorf("%s: skipped %d, want %d", tt.name, len(result.Skipped), tt.skipped)
+		}
+		if failed := summaryOf(result.Failed); failed != tt.failed {
+			t.Errorf("%s: failed = %q, want %q", tt.name, failed, tt.failed)
+		}
+		if held := summaryOf(result.Held); held != tt.held {
+			t.Errorf("%s: held = %q, want %q", tt.name, held, tt.held)
+		}
+
+		ops, _ := PendingOperations()
+		var pending []string
+		for _, op := range ops {
+			pending = append(pending, op.Summary)
+		}
+		if !slices.Equal(pending, tt.pending) || result.Remaining != len(tt.pending) {
+			t.Errorf("%s: pending = %v (remaining %d), want %v", tt.name, pending, result.Remaining, tt.pending)
+		}
+		if tt.failed != "" && (ops[0].Attempts != 1 || ops[0].LastError == "") {
+			t.Errorf("%s: failed operation has %d attempts, last error %q", tt.name, ops[0].Attempts, ops[0].LastError)
+		}
+		if tt.existing > 0 && (ops[0].WorkItem.Existing == nil || *ops[0].WorkItem.Existing != tt.existing) {
+			t.Errorf("%s: existing = %v, want %d", tt.name, ops[0].WorkItem.Existing, tt.existing)
+		}
+	}
+}
+
+// summaryOf returns the summary of an optional operation.
+func summaryOf(op *Operation) string {
+	if op == nil {
+		return ""
+	}
+	return op.Summary
+}
+
+func TestOutboxLock(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	wait := outboxLockWait
+	outboxLockWait = 100 * time.Millisecond
+	t.Cleanup(func() { outboxLockWait = wait })
+
+	lock, err := lockOutbox()
+	if err != nil {
+		t.Fatalf("lockOutbox: %v", err)
+	}
+	if _, err := lockOutbox(); err == nil {
+		t.Fatal("lockOutbox succeeded while the outbox was locked")
+	}
+	if err := enqueue(Operation{Key: "00000001", Summary: "blocked"}); err == nil {
+		t.Error("enqueue succeeded while the outbox was locked")
+	}
+
+	// A lock left behind by a crashed process is taken over once stale
+	old := time.Now().Add(-outboxLockStale - time.Minute)
+	os.Chtimes(lock.path, old, old)
+	stolen, err := lockOutbox()
+	if err != nil {
+		t.Fatalf("lockOutbox with a stale lock: %v", err)
+	}
+	stolen.release()
+	if _, err := os.Stat(lock.path); !os.IsNotExist(err) {
+		t.Errorf("release left %s behind", lock.path)
+	}
+	if err := enqueue(Operation{Key: "00000001", Summary: "queued"}); err != nil {
+		t.Errorf("enqueue after release: %v", err)
+	}
+}
@@ -0,0 +1,146 @@
+package youtrack
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+// PlanEntry compares the estimation assigned to one person with their capacity.
+type PlanEntry struct {
+	Assignee   string
+	Issues     int
+	Estimation time.Duration
+	Capacity   time.Duration
+	WorkDays   int
+}
+
+// Overcommitted reports whether more work is assigned than the person can do.
+func (e PlanEntry) Overcommitted() bool {
+	return e.Estimation > e.Capacity
+}
+
+// SprintPlan is the capacity check of a sprint.
+type SprintPlan struct {
+	Sprint      Sprint
+	Entries     []PlanEntry
+	Unassigned  []Issue
+	Unestimated []Issue
+}
+
+// PlanSprint groups sprint estimation by assignee and compares it with the
+// capacity configured for each person. Issues with several assignees are split evenly.
+func PlanSprint(cfg config.Config, sprint Sprint, issues []Issue) SprintPlan {
+	plan := SprintPlan{Sprint: sprint}
+	entries := make(map[string]*PlanEntry)
+	entry := func(name string) *PlanEntry {
+		if e, ok := entries[name]; ok {
+			return e
+		}
+		e := &PlanEntry{Assignee: name}
+		e.WorkDays, e.Capacity = personCapacity(cfg, name, sprint)
+		entries[name] = e
+		return e
+	}
+
+	// Everyone with configured capacity shows up, even without work assigned
+	for name := range cfg.Capacity.People {
+		entry(name)
+	}
+
+	for _, iss := range issues {
+		estimation := SumEstimation([]Issue{iss})
+		if estimation == 0 {
+			plan.Unestimated = append(plan.Unestimated, iss)
+		}
+
+		names := issueAssigneeNames(iss)
+		if len(names) == 0 {
+			plan.Unassigned = append(plan.Unassigned, iss)
+			continue
+		}
+		for _, name := range names {
+			e := entry(name)
+			e.Issues++
+			e.Estimation += estimation / time.Duration(len(names))
+		}
+	}
+
+	for _, e := range entries {
+		plan.Entries = append(plan.Entries, *e)
+	}
+	sort.Slice(plan.Entries, func(i, j int) bool {
+		return plan.Entries[i].Assignee < plan.Entries[j].Assignee
+	})
+	return plan
+}
+
+// personCapacity returns the working days and total capacity of a person during a sprint.
+func personCapacity(cfg config.Config, name string, sprint Sprint) (int, time.Duration) {
+	if sprint.Start == 0 || sprint.Finish == 0 {
+		return 0, 0
+	}
+
+	days := 0
+	last := startOfDay(unixMilliToTime(sprint.Finish))
+	for day := startOfDay(unixMilliToTime(sprint.Start)); !day.After(last); day = day.AddDate(0, 0, 1) {
+		if cfg.IsWorkday(day) && !cfg.Capacity.IsDayOff(name, day) {
+			days++
+		}
+	}
+	hours := float64(days) * cfg.Capacity.HoursPerDayFor(name)
+	return days, time.Duration(hours * float64(time.Hour))
+}
+
+// PrintSprintPlan prints the capacity table, flagging overcommitted people and unassigned work.
+func PrintSprintPlan(plan SprintPlan) {
+	fmt.Printf("Capacity plan for sprint '%s' (%s → %s)\n", plan.Sprint.Name,
+		formatSprintDate(plan.Sprint.Start), formatSprintDate(plan.Sprint.Finish))
+	if plan.Sprint.Start == 0 || plan.Sprint.Finish == 0 {
+		fmt.Println("Warning: sprint has no dates, capacity cannot be computed.")
+	}
+
+	fmt.Printf("%-20s\t%-6s\t%-9s\t%-12s\t%-12s\t%s\n", "Assignee", "Issues", "Work Days", "Estimation", "Capacity", "Load")
+	var overcommitted []string
+	for _, e := range plan.Entries {
+		load := "N/A"
+		if e.Capacity > 0 {
+			load = fmt.Sprintf("%.0f%%", float64(e.Estimation)/float64(e.Capacity)*100)
+		}
+		flag := ""
+		if e.Overcommitted() {
+			flag = "  ⚠ overcommitted"
+			overcommitted = append(overcommitted, e.Assignee)
+		}
+		fmt.Printf("%-20s\t%-6d\t%-9d\t%-12s\t%-12s\t%s%s\n", e.Assignee, e.Issues, e.WorkDays,
+			HumanizeDuration(e.Estimation), HumanizeDuration(e.Capacity), load, flag)
+	}
+
+	if len(overcommitted) > 0 {
+		fmt.Printf("\n%d overcommitted: %s\n", len(overcommitted), strings.Join(overcommitted, ", "))
+	}
+
+	if len(plan.Unassigned) > 0 {
+		fmt.Printf("\nUnassigned work (%s):\n", HumanizeDuration(SumEstimation(plan.Unassigned)))
+		for _, iss := range plan.Unassigned {
+			fmt.Printf("  %-15s\t%-12s\t%s\n", iss.ID, orNA(IssueField(iss, "Estimation")), iss.Summary)
+		}
+	}
+
+	if len(plan.Unestimated) > 0 {
+		fmt.Printf("\n%d issue(s) without estimation:\n", len(plan.Unestimated))
+		for _, iss := range plan.Unestimated {
+			fmt.Printf("  %-15s\t%s\n", iss.ID, iss.Summary)
+		}
+	}
+}
+
+// orNA returns s, or "N/A" when it is empty.
+func orNA(s string) string {
+	if s == "" {
+		return "N/A"
+	}
+	return s
+}
@@ -0,0 +1,163 @@
+package youtrack
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeGroupings are the supported values of TimeReport grouping. "type" is the work item
+// type (e.g. Development), "issue-type" the Type field of the issue (e.g. Bug).
+var TimeGroupings = []string{"project", "type", "issue-type", "author", "issue", "week"}
+
+// TimeReportRow is the logged time of one group.
+type TimeReportRow struct {
+	Key      string        `json:"key"`
+	Summary  string        `json:"summary,omitempty"` // Issue summary when grouping by issue
+	Duration time.Duration `json:"-"`
+	Minutes  int           `json:"minutes"`
+	Percent  float64       `json:"percent"`
+	Items    int           `json:"items"`
+}
+
+// TimeReport aggregates work items per group.
+type TimeReport struct {
+	Since   time.Time       `json:"-"`
+	Until   time.Time       `json:"-"`
+	GroupBy string          `json:"group_by"`
+	Rows    []TimeReportRow `json:"rows"`
+	Total   time.Duration   `json:"-"`
+	Minutes int             `json:"total_minutes"`
+}
+
+// BuildTimeReport groups work items by project, work item type, issue type, author, issue or week. Rows are
+// ordered by time spent, except weeks which are ordered chronologically.
+func BuildTimeReport(items []WorkItem, groupBy string, since, until time.Time) (TimeReport, error) {
+	report := TimeReport{Since: since, Until: until, GroupBy: groupBy}
+
+	key, err := timeGroupKey(groupBy)
+	if err != nil {
+		return report, err
+	}
+
+	index := make(map[string]int)
+	for _, item := range items {
+		k := key(item)
+		i, ok := index[k]
+		if !ok {
+			i = len(report.Rows)
+			index[k] = i
+			report.Rows = append(report.Rows, TimeReportRow{Key: k})
+			if groupBy == "issue" && item.Issue != nil {
+				report.Rows[i].Summary = item.Issue.Summary
+			}
+		}
+		d := WorkItemDuration(item)
+		report.Rows[i].Duration += d
+		report.Rows[i].Items++
+		report.Total += d
+	}
+
+	for i := range report.Rows {
+		report.Rows[i].Minutes = int(report.Rows[i].Duration / time.Minute)
+		if report.Total > 0 {
+			report.Rows[i].Percent = float64(report.Rows[i].Duration) / float64(report.Total) * 100
+		}
+	}
+	report.Minutes = int(report.Total / time.Minute)
+
+	sort.SliceStable(report.Rows, func(i, j int) bool {
+		a, b := report.Rows[i], report.Rows[j]
+		if groupBy != "week" && a.Duration != b.Duration {
+			return a.Duration > b.Duration
+		}
+		return a.Key < b.Key
+	})
+	return report, nil
+}
+
+// timeGroupKey returns the function deriving the group of a work item.
+func timeGroupKey(groupBy string) (func(WorkItem) string, error) {
+	switch groupBy {
+	case "project":
+		return func(item WorkItem) string {
+			project, _, _ := strings.Cut(workItemIssueID(item), "-")
+			return project
+		}, nil
+	case "type":
+		return workItemTypeName, nil
+	case "issue-type":
+		return func(item WorkItem) string {
+			if item.Issue != nil {
+				if t := IssueField(*item.Issue, "Type"); t != "" {
+					return t
+				}
+			}
+			return "N/A"
+		}, nil
+	case "author":
+		return func(item WorkItem) string { return authorName(item.Author) }, nil
+	case "issue":
+		return workItemIssueID, nil
+	case "week":
+		return func(item WorkItem) string {
+			year, week := workItemDay(item).ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported group '%s' (use %s)", groupBy, strings.Join(TimeGroupings, ", "))
+	}
+}
+
+// PrintTimeReport prints the report as a table with totals and percentages.
+func PrintTimeReport(report TimeReport) {
+	fmt.Printf("Time logged %s - %s by %s\n\n", report.Since.Format("2006-01-02"), report.Until.Format("2006-01-02"), report.GroupBy)
+	if len(report.Rows) == 0 {
+		fmt.Println("No work items found.")
+		return
+	}
+
+	header := strings.ToUpper(report.GroupBy[:1]) + report.GroupBy[1:]
+	fmt.Printf("%-20s\t%-10s\t%7s\t%5s\t%s\n", header, "Time", "Share", "Items", "Summary")
+	for _, row := range report.Rows {
+		fmt.Printf("%-20s\t%-10s\t%6.1f%%\t%5d\t%s\n", row.Key, HumanizeDuration(row.Duration), row.Percent, row.Items, row.Summary)
+	}
+	fmt.Printf("%-20s\t%-10s\t%6.1f%%\n", "Total", HumanizeDuration(report.Total), 100.0)
+}
+
+// WriteTimeReportCSV writes the report rows as CSV with times in decimal hours.
+func WriteTimeReportCSV(w io.Writer, report TimeReport) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{report.GroupBy, "summary", "hours", "percent", "items"}); err != nil {
+		return err
+	}
+	for _, row := range report.Rows {
+		record := []string{row.Key, row.Summary, formatHours(row.Duration), strconv.FormatFloat(row.Percent, 'f', 2, 64), strconv.Itoa(row.Items)}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	if err := cw.Write([]string{"Total", "", formatHours(report.Total), "100.00", ""}); err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteTimeReportJSON writes the report as indented JSON.
+func WriteTimeReportJSON(w io.Writer, report TimeReport) error {
+	out := struct {
+		Since string `json:"since"`
+		Until string `json:"until"`
+		TimeReport
+	}{report.Since.Format("2006-01-02"), report.Until.Format("2006-01-02"), report}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
@@ -0,0 +1,45 @@
+package youtrack
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildTimeReportTypeGroupings(t *testing.T) {
+	bug := &Issue{ID: "DP-1", CustomFields: []CustomField{{Name: "Type", Value: map[string]interface{}{"name": "Bug"}}}}
+	feature := &Issue{ID: "DP-2", CustomFields: []CustomField{{Name: "Type", Value: map[string]interface{}{"name": "Feature"}}}}
+	dev := &WorkItemType{Name: "Development"}
+	items := []WorkItem{
+		{Duration: Duration{Minutes: 60}, Type: dev, Issue: bug},
+		{Duration: Duration{Minutes: 30}, Type: dev, Issue: feature},
+		{Duration: Duration{Minutes: 15}, Issue: bug},
+	}
+
+	tests := []struct {
+		groupBy string
+		want    map[string]int
+	}{
+		{"type", map[string]int{"Development": 90, "N/A": 15}},
+		{"issue-type", map[string]int{"Bug": 75, "Feature": 30}},
+	}
+	for _, tt := range tests {
+		report, err := BuildTimeReport(items, tt.groupBy, time.Time{}, time.Time{})
+		if err != nil {
+			t.Fatalf("BuildTimeReport(%s): %v", tt.groupBy, err)
+		}
+		got := make(map[string]int)
+		for _, row := range report.Rows {
+			got[row.Key] = row.Minutes
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("BuildTimeReport(%s) rows = %v, want %v", tt.groupBy, got, tt.want)
+			continue
+		}
+		for k, v := range tt.want {
+			if got[k] != v {
+				t.Errorf("BuildTimeReport(%s) rows = %v, want %v", tt.groupBy, got, tt.want)
+				break
+			}
+		}
+	}
+}
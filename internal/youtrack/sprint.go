@@ -9,53 +9,217 @@ import (
 	"youtrack-cli/internal/config"
 )
 
-// DetermineSprint determines the sprint name to use based on flags, default config, or latest active sprint.
-func DetermineSprint(cfg config.Config, flagSprintName string) (string, error) {
-	// 1. If sprint name is provided via flag, use it directly
-	if flagSprintName != "" {
-		return flagSprintName, nil
+// Sprint keywords accepted wherever a sprint name is expected.
+const (
+	SprintCurrent  = "current"
+	SprintNext     = "next"
+	SprintPrevious = "previous"
+)
+
+// IsSprintKeyword reports whether name is one of the relative sprint keywords.
+func IsSprintKeyword(name string) bool {
+	switch name {
+	case SprintCurrent, SprintNext, SprintPrevious:
+		return true
 	}
+	return false
+}
 
-	// 2. If default sprint is configured, use it
-	if cfg.DefaultSprint != "" {
-		return cfg.DefaultSprint, nil
+// DetermineSprint determines the sprint name to use based on flags, default config, or the board's current sprint,
+// together with the exact name of the configured board for use in queries. Keywords (current, next, previous) are
+// resolved against the configured board. The board is looked up once; when a plain sprint name is given and the
+// lookup fails, the configured board name is returned unchanged.
+func DetermineSprint(cfg config.Config, flagSprintName string) (sprintName, boardName string, err error) {
+	// 1. Flag wins, then the configured default, then the board's current sprint
+	sprintName = flagSprintName
+	if sprintName == "" {
+		sprintName = cfg.DefaultSprint
+	}
+	if sprintName == "" {
+		sprintName = SprintCurrent
 	}
 
-	// 3. Otherwise, try to find the latest active sprint
 	if cfg.BoardName == "" {
-		return "", fmt.Errorf("board name is not configured, cannot determine latest sprint")
+		if IsSprintKeyword(sprintName) {
+			return "", "", fmt.Errorf("board name is not configured, cannot determine %s sprint", sprintName)
+		}
+		return sprintName, "", nil
 	}
 
-	sprints, err := ListSprints(cfg, cfg.BoardName)
+	// 2. Board names in queries must match exactly; resolve IDs and partial names first
+	board, err := FindBoard(cfg, cfg.BoardName)
 	if err != nil {
-		return "", fmt.Errorf("failed to list sprints for board '%s': %w", cfg.BoardName, err)
+		if IsSprintKeyword(sprintName) {
+			return "", "", err
+		}
+		return sprintName, cfg.BoardName, nil
 	}
 
+	// 3. Plain sprint names are used as-is, keywords need the board's sprints
+	if !IsSprintKeyword(sprintName) {
+		return sprintName, board.Name, nil
+	}
+	sprints, err := ListBoardSprints(cfg, board)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to list sprints for board '%s': %w", board.Name, err)
+	}
+	sprint, err := pickSprint(board, sprints, sprintName, time.Now())
+	if err != nil {
+		return "", "", err
+	}
+	return sprint.Name, board.Name, nil
+}
+
+// ResolveSprint finds a sprint on the given board by name or keyword.
+// The resolved board is returned as well so callers can make further board requests.
+func ResolveSprint(cfg config.Config, boardName, ref string) (AgileBoard, Sprint, error) {
+	board, err := FindBoard(cfg, boardName)
+	if err != nil {
+		return AgileBoard{}, Sprint{}, err
+	}
+	sprint, err := BoardSprint(cfg, board, ref)
+	return board, sprint, err
+}
+
+// BoardSprint finds a sprint of an already resolved board by name or keyword. A board with
+// sprints disabled (known once it comes from FetchBoardDetails) keeps all its issues in one
+// implicit sprint, which is returned for any ref.
+func BoardSprint(cfg config.Config, board AgileBoard, ref string) (Sprint, error) {
+	if s := board.SprintsSettings; s != nil && s.DisableSprints {
+		if board.CurrentSprint == nil {
+			return Sprint{}, fmt.Errorf("board '%s' has sprints disabled and no issues can be listed for it", board.Name)
+		}
+		return *board.CurrentSprint, nil
+	}
+
+	sprints, err := ListBoardSprints(cfg, board)
+	if err != nil {
+		return Sprint{}, fmt.Errorf("failed to list sprints for board '%s': %w", board.Name, err)
+	}
+	return pickSprint(board, sprints, ref, time.Now())
+}
+
+// pickSprint selects a sprint by name or keyword. sprints is sorted in place.
+func pickSprint(board AgileBoard, sprints []Sprint, ref string, now time.Time) (Sprint, error) {
 	if len(sprints) == 0 {
-		return "", fmt.Errorf("no sprints found for board '%s'", cfg.BoardName)
+		return Sprint{}, fmt.Errorf("no sprints found for board '%s'", board.Name)
 	}
 
-	// Heuristic 1: Sort by finish date (descending) if available
-	// This assumes YouTrack API returns valid start/finish dates.
-	sort.Slice(sprints, func(i, j int) bool {
-		// Prioritize sprints with valid finish dates
-		if sprints[i].Finish > 0 && sprints[j].Finish > 0 {
-			return sprints[i].Finish > sprints[j].Finish // Newest finish date first
-		} else if sprints[i].Finish > 0 { // i has finish date, j doesn't
+	if !IsSprintKeyword(ref) {
+		for _, s := range sprints {
+			if s.Name == ref {
+				return s, nil
+			}
+		}
+		return Sprint{}, fmt.Errorf("sprint '%s' not found on board '%s'", ref, board.Name)
+	}
+
+	sortSprints(sprints)
+	idx := currentSprintIndex(board, sprints, now)
+
+	switch ref {
+	case SprintCurrent:
+		if idx < 0 {
+			return Sprint{}, fmt.Errorf("board '%s' has no current sprint", board.Name)
+		}
+	case SprintNext:
+		if idx >= 0 {
+			idx++
+		} else {
+			idx = firstSprintAfter(sprints, now)
+		}
+	case SprintPrevious:
+		if idx >= 0 {
+			idx--
+		} else {
+			idx = lastSprintBefore(sprints, now)
+		}
+	}
+
+	if idx < 0 || idx >= len(sprints) {
+		return Sprint{}, fmt.Errorf("board '%s' has no %s sprint", board.Name, ref)
+	}
+	return sprints[idx], nil
+}
+
+// currentSprintIndex returns the index of the current sprint in sorted sprints, or -1.
+// The board's currentSprint wins, then the sprint isCurrent flag, then the date range.
+func currentSprintIndex(board AgileBoard, sprints []Sprint, now time.Time) int {
+	if board.CurrentSprint != nil {
+		for i, s := range sprints {
+			if s.ID == board.CurrentSprint.ID {
+				return i
+			}
+		}
+	}
+
+	for i, s := range sprints {
+		if s.IsCurrent && !s.IsArchived {
+			return i
+		}
+	}
+
+	ms := now.UnixMilli()
+	for i, s := range sprints {
+		if !s.IsArchived && s.Start > 0 && s.Start <= ms && ms <= s.Finish {
+			return i
+		}
+	}
+	return -1
+}
+
+// firstSprintAfter returns the index of the first sprint starting after now, or -1.
+func firstSprintAfter(sprints []Sprint, now time.Time) int {
+	ms := now.UnixMilli()
+	for i, s := range sprints {
+		if s.Start > ms {
+			return i
+		}
+	}
+	return -1
+}
+
+// lastSprintBefore returns the index of the last sprint finished before now, or -1.
+func lastSprintBefore(sprints []Sprint, now time.Time) int {
+	ms := now.UnixMilli()
+	for i := len(sprints) - 1; i >= 0; i-- {
+		if sprints[i].Finish > 0 && sprints[i].Finish < ms {
+			return i
+		}
+	}
+	return -1
+}
+
+// sortSprints orders sprints chronologically by start date.
+// Sprints without dates are ordered by the trailing number in their name and placed last.
+func sortSprints(sprints []Sprint) {
+	sort.SliceStable(sprints, func(i, j int) bool {
+		if sprints[i].Start > 0 && sprints[j].Start > 0 {
+			return sprints[i].Start < sprints[j].Start
+		} else if sprints[i].Start > 0 { // i has start date, j doesn't
 			return true
-		} else if sprints[j].Finish > 0 { // j has finish date, i doesn't
+		} else if sprints[j].Start > 0 { // j has start date, i doesn't
 			return false
 		}
-		// Fallback to sorting by name with numbers if no valid finish dates
+		// Fallback to sorting by name with numbers if no valid start dates
 		numI := extractNumberFromName(sprints[i].Name)
 		numJ := extractNumberFromName(sprints[j].Name)
 		if numI != numJ {
-			return numI > numJ // Sort by number descending
+			return numI < numJ
 		}
-		return sprints[i].Name > sprints[j].Name // Fallback to alphabetical if numbers are same
+		return sprints[i].Name < sprints[j].Name
 	})
+}
 
-	return sprints[0].Name, nil
+// sprintLabels maps sprint IDs to the keyword that resolves to them on the board.
+func sprintLabels(board AgileBoard, sprints []Sprint, now time.Time) map[string]string {
+	labels := make(map[string]string)
+	for _, kw := range []string{SprintPrevious, SprintCurrent, SprintNext} {
+		if s, err := pickSprint(board, sprints, kw, now); err == nil {
+			labels[s.ID] = kw
+		}
+	}
+	return labels
 }
 
 // extractNumberFromName extracts a number from a sprint name for sorting.
@@ -0,0 +1,365 @@
+package youtrack
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+// ScopeChange is a change of the sprint scope after its first day.
+type ScopeChange struct {
+	Time   time.Time
+	Issue  Issue
+	Change string // e.g. "added to sprint" or "estimation 1d → 2d"
+	Author string
+}
+
+// Contribution is one person's share of a sprint.
+type Contribution struct {
+	Person    string
+	Resolved  int           // Completed issues assigned to the person
+	Completed time.Duration // Estimation of those issues
+	Logged    time.Duration // Time logged on sprint issues during the sprint
+}
+
+// SprintReport is the material of a sprint review document.
+type SprintReport struct {
+	Board         string
+	Sprint        Sprint
+	Completed     []Issue // Resolved by the end of the sprint
+	NotCompleted  []Issue
+	ScopeChanges  []ScopeChange
+	Estimation    time.Duration // Estimation of all sprint issues
+	Done          time.Duration // Estimation of the completed issues
+	Spent         time.Duration // Spent time of all sprint issues
+	Logged        time.Duration // Time logged on sprint issues during the sprint
+	Contributions []Contribution
+	Burndown      []BurndownPoint
+}
+
+// BuildSprintReport collects completed and open issues, scope changes, totals, per-person
+// contribution and the burndown of a sprint. Scope changes are issues added to the sprint,
+// removed from it or re-estimated after its first day, which is the baseline of the burndown.
+// Removed issues only appear as scope changes and in the burndown until they left.
+func BuildSprintReport(cfg config.Config, board AgileBoard, sprint Sprint, issues []Issue) (SprintReport, error) {
+	report := SprintReport{Board: board.Name, Sprint: sprint, Estimation: SumEstimation(issues), Spent: SumSpentTime(issues)}
+	histories, err := fetchSprintHistories(cfg, board, sprint, issues)
+	if err != nil {
+		return report, err
+	}
+
+	baseline := sprintBaseline(sprint)
+	for _, h := range histories {
+		report.ScopeChanges = append(report.ScopeChanges, h.Additions...)
+		report.ScopeChanges = append(report.ScopeChanges, h.Removals...)
+		for _, a := range h.EstimationChanges {
+			if at := unixMilliToTime(a.Timestamp); at.After(baseline) {
+				change := fmt.Sprintf("estimation %s → %s", orNA(activityValue(a.Removed)), orNA(activityValue(a.Added)))
+				report.ScopeChanges = append(report.ScopeChanges, ScopeChange{Time: at, Issue: h.Issue, Change: change, Author: authorName(a.Author)})
+			}
+		}
+	}
+	report.Completed, report.NotCompleted = splitCompleted(sprint, histories)
+	sort.SliceStable(report.ScopeChanges, func(i, j int) bool { return report.ScopeChanges[i].Time.Before(report.ScopeChanges[j].Time) })
+	report.Done = SumEstimation(report.Completed)
+	report.Burndown = burndownSeries(sprint, histories, time.Now())
+
+	items, err := FetchWorkItems(cfg, WorkItemFilter{
+		Query: SprintQuery(board.Name, sprint.Name),
+		Since: unixMilliToTime(sprint.Start),
+		Until: unixMilliToTime(sprint.Finish),
+	})
+	if err != nil {
+		return report, fmt.Errorf("failed to fetch work items: %w", err)
+	}
+	for _, item := range items {
+		report.Logged += WorkItemDuration(item)
+	}
+	report.Contributions = sprintContributions(report.Completed, items)
+	return report, nil
+}
+
+// splitCompleted separates the issues still in the sprint into those resolved by its end
+// and the rest. Issues removed from the sprint belong to neither.
+func splitCompleted(sprint Sprint, histories []issueHistory) (completed, notCompleted []Issue) {
+	for _, h := range histories {
+		switch {
+		case h.RemovedAt != 0:
+		case h.Issue.Resolved != 0 && h.Issue.Resolved <= sprint.Finish:
+			completed = append(completed, h.Issue)
+		default:
+			notCompleted = append(notCompleted, h.Issue)
+		}
+	}
+	return completed, notCompleted
+}
+
+// sprintContributions credits completed issues to their assignees and logged time to its authors.
+func sprintContributions(completed []Issue, items []WorkItem) []Contribution {
+	byPerson := make(map[string]*Contribution)
+	person := func(name string) *Contribution {
+		c, ok := byPerson[name]
+		if !ok {
+			c = &Contribution{Person: name}
+			byPerson[name] = c
+		}
+		return c
+	}
+	for _, iss := range completed {
+		c := person(IssueAssignee(iss))
+		c.Resolved++
+		c.Completed += SumEstimation([]Issue{iss})
+	}
+	for _, item := range items {
+		person(authorName(item.Author)).Logged += WorkItemDuration(item)
+	}
+
+	contributions := make([]Contribution, 0, len(byPerson))
+	for _, c := range byPerson {
+		contributions = append(contributions, *c)
+	}
+	sort.Slice(contributions, func(i, j int) bool {
+		a, b := contributions[i], contributions[j]
+		if a.Completed != b.Completed {
+			return a.Completed > b.Completed
+		}
+		if a.Logged != b.Logged {
+			return a.Logged > b.Logged
+		}
+		return a.Person < b.Person
+	})
+	return contributions
+}
+
+// authorName returns the full name of an author, falling back to the login.
+func authorName(a Author) string {
+	if a.FullName != "" {
+		return a.FullName
+	}
+	return orNA(a.Login)
+}
+
+// reportSection is one titled part of a sprint report: a table, or raw markup such as a chart.
+type reportSection struct {
+	Title  string
+	Header []string
+	Rows   [][]string
+	Empty  string // Shown instead of an empty table
+	Raw    string // Inserted as is instead of a table
+}
+
+// sprintReportSections lays out the report independently of the output format.
+func sprintReportSections(r SprintReport) []reportSection {
+	total := len(r.Completed) + len(r.NotCompleted)
+	summary := reportSection{Title: "Summary", Header: []string{"Metric", "Value"}, Rows: [][]string{
+		{"Issues completed", fmt.Sprintf("%d of %d%s", len(r.Completed), total, share(float64(len(r.Completed)), float64(total)))},
+		{"Estimation completed", fmt.Sprintf("%s of %s%s", HumanizeDuration(r.Done), HumanizeDuration(r.Estimation), share(float64(r.Done), float64(r.Estimation)))},
+		{"Spent time", HumanizeDuration(r.Spent)},
+		{"Logged during sprint", HumanizeDuration(r.Logged)},
+		{"Scope changes", fmt.Sprintf("%d", len(r.ScopeChanges))},
+	}}
+
+	issueHeader := []string{"ID", "Summary", "State", "Assignee", "Estimation", "Spent"}
+	issueRows := func(issues []Issue) [][]string {
+		var rows [][]string
+		for _, iss := range issues {
+			rows = append(rows, []string{iss.ID, iss.Summary, orNA(IssueField(iss, "State")), IssueAssignee(iss),
+				HumanizeDuration(SumEstimation([]Issue{iss})), HumanizeDuration(SumSpentTime([]Issue{iss}))})
+		}
+		return rows
+	}
+
+	var scopeRows [][]string
+	for _, c := range r.ScopeChanges {
+		scopeRows = append(scopeRows, []string{c.Time.Format("2006-01-02"), c.Issue.ID, c.Issue.Summary, c.Change, c.Author})
+	}
+	var contributionRows [][]string
+	for _, c := range r.Contributions {
+		contributionRows = append(contributionRows, []string{c.Person, fmt.Sprintf("%d", c.Resolved),
+			HumanizeDuration(c.Completed), HumanizeDuration(c.Logged)})
+	}
+
+	return []reportSection{
+		summary,
+		{Title: "Burndown", Raw: BurndownSVG(r.Burndown)},
+		{Title: fmt.Sprintf("Completed (%d)", len(r.Completed)), Header: issueHeader, Rows: issueRows(r.Completed), Empty: "No issues completed."},
+		{Title: fmt.Sprintf("Not completed (%d)", len(r.NotCompleted)), Header: issueHeader, Rows: issueRows(r.NotCompleted), Empty: "All issues completed."},
+		{Title: "Scope changes", Header: []string{"Date", "ID", "Summary", "Change", "By"}, Rows: scopeRows, Empty: "No scope changes after the first day."},
+		{Title: "Contribution", Header: []string{"Person", "Resolved", "Completed estimation", "Logged"}, Rows: contributionRows, Empty: "No contributions recorded."},
+	}
+}
+
+// share formats part/total as " (n%)", or "" when total is zero.
+func share(part, total float64) string {
+	if total == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (%.0f%%)", part/total*100)
+}
+
+// sprintReportSubtitle describes the board and dates of the reported sprint.
+func sprintReportSubtitle(r SprintReport) string {
+	return fmt.Sprintf("Board %s, %s – %s", r.Board, formatSprintDate(r.Sprint.Start), formatSprintDate(r.Sprint.Finish))
+}
+
+// markdownEscaper escapes text taken from YouTrack so that it renders literally on a single
+// Markdown line or table cell: formatting characters are backslash-escaped and HTML is
+// written as entities.
+var markdownEscaper = strings.NewReplacer(
+	"\\", "\\\\", "`", "\\`", "*", "\\*", "_", "\\_", "~", "\\~", "[", "\\[", "]", "\\]", "|", "\\|",
+	"&", "&amp;", "<", "&lt;", ">", "&gt;",
+	"\r\n", " ", "\n", " ", "\r", " ",
+)
+
+// WriteSprintReportMarkdown writes the report as Markdown with the burndown as inline SVG.
+func WriteSprintReportMarkdown(w io.Writer, r SprintReport) {
+	fmt.Fprintf(w, "# Sprint review: %s\n\n%s\n", markdownEscaper.Replace(r.Sprint.Name), markdownEscaper.Replace(sprintReportSubtitle(r)))
+	if r.Sprint.Goal != "" {
+		fmt.Fprintf(w, "\n**Goal:** %s\n", markdownEscaper.Replace(r.Sprint.Goal))
+	}
+
+	for _, s := range sprintReportSections(r) {
+		fmt.Fprintf(w, "\n## %s\n\n", s.Title)
+		switch {
+		case s.Raw != "":
+			fmt.Fprintln(w, s.Raw)
+		case len(s.Rows) == 0:
+			fmt.Fprintln(w, s.Empty)
+		default:
+			fmt.Fprintf(w, "| %s |\n", strings.Join(s.Header, " | "))
+			fmt.Fprintf(w, "|%s\n", strings.Repeat(" --- |", len(s.Header)))
+			for _, row := range s.Rows {
+				cells := make([]string, len(row))
+				for i, c := range row {
+					cells[i] = markdownEscaper.Replace(c)
+				}
+				fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | "))
+			}
+		}
+	}
+}
+
+// sprintReportStyle is the stylesheet of the HTML report.
+const sprintReportStyle = `body { font-family: sans-serif; max-width: 960px; margin: 2em auto; color: #222; }
+table { border-collapse: collapse; width: 100%; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }
+th { background: #f4f4f4; }`
+
+// WriteSprintReportHTML writes the report as a standalone HTML document with the burndown as inline SVG.
+func WriteSprintReportHTML(w io.Writer, r SprintReport) {
+	title := html.EscapeString("Sprint review: " + r.Sprint.Name)
+	fmt.Fprintf(w, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n<style>\n%s\n</style>\n</head>\n<body>\n", title, sprintReportStyle)
+	fmt.Fprintf(w, "<h1>%s</h1>\n<p>%s</p>\n", title, html.EscapeString(sprintReportSubtitle(r)))
+	if r.Sprint.Goal != "" {
+		fmt.Fprintf(w, "<p><strong>Goal:</strong> %s</p>\n", html.EscapeString(r.Sprint.Goal))
+	}
+
+	for _, s := range sprintReportSections(r) {
+		fmt.Fprintf(w, "<h2>%s</h2>\n", html.EscapeString(s.Title))
+		switch {
+		case s.Raw != "":
+			fmt.Fprintln(w, s.Raw)
+		case len(s.Rows) == 0:
+			fmt.Fprintf(w, "<p>%s</p>\n", html.EscapeString(s.Empty))
+		default:
+			fmt.Fprint(w, "<table>\n<tr>")
+			for _, h := range s.Header {
+				fmt.Fprintf(w, "<th>%s</th>", html.EscapeString(h))
+			}
+			fmt.Fprintln(w, "</tr>")
+			for _, row := range s.Rows {
+				fmt.Fprint(w, "<tr>")
+				for _, c := range row {
+					fmt.Fprintf(w, "<td>%s</td>", html.EscapeString(c))
+				}
+				fmt.Fprintln(w, "</tr>")
+			}
+			fmt.Fprintln(w, "</table>")
+		}
+	}
+	fmt.Fprintln(w, "</body>\n</html>")
+}
+
+// Burndown chart geometry in SVG user units.
+const (
+	svgWidth  = 640
+	svgHeight = 290
+	svgLeft   = 50.0
+	svgRight  = 620.0
+	svgTop    = 20.0
+	svgBottom = 240.0
+)
+
+// BurndownSVG renders the remaining estimation, the ideal line and the scope of a sprint
+// as a self-contained SVG chart with the y axis in hours. It contains no blank lines so
+// that Markdown renderers keep it as a single HTML block.
+func BurndownSVG(points []BurndownPoint) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="11">`,
+		svgWidth, svgHeight, svgWidth, svgHeight)
+	b.WriteString("\n")
+	if len(points) == 0 {
+		fmt.Fprintf(&b, `<text x="%g" y="%g">No burndown data</text>`+"\n</svg>", svgLeft, svgTop+20)
+		return b.String()
+	}
+
+	maxHours := 0.0
+	for _, p := range points {
+		maxHours = math.Max(maxHours, math.Max(p.Scope.Hours(), math.Max(p.Remaining.Hours(), p.Ideal.Hours())))
+	}
+	if maxHours == 0 {
+		maxHours = 1
+	}
+	x := func(i int) float64 {
+		if len(points) == 1 {
+			return svgLeft
+		}
+		return svgLeft + (svgRight-svgLeft)*float64(i)/float64(len(points)-1)
+	}
+	y := func(d time.Duration) float64 {
+		return svgBottom - (svgBottom-svgTop)*d.Hours()/maxHours
+	}
+
+	// Horizontal grid with hour labels, then the date labels along the x axis
+	for _, f := range []float64{0, 0.25, 0.5, 0.75, 1} {
+		gy := svgBottom - (svgBottom-svgTop)*f
+		fmt.Fprintf(&b, `<line x1="%g" y1="%.1f" x2="%g" y2="%.1f" stroke="#ddd"/>`+"\n", svgLeft, gy, svgRight, gy)
+		fmt.Fprintf(&b, `<text x="%g" y="%.1f" text-anchor="end">%.0fh</text>`+"\n", svgLeft-6, gy+4, maxHours*f)
+	}
+	step := int(math.Ceil(float64(len(points)) / 10))
+	for i, p := range points {
+		if i%step == 0 || i == len(points)-1 {
+			fmt.Fprintf(&b, `<text x="%.1f" y="%g" text-anchor="middle">%s</text>`+"\n", x(i), svgBottom+16, p.Date.Format("01-02"))
+		}
+	}
+
+	line := func(color, dash string, value func(BurndownPoint) time.Duration, actualOnly bool) {
+		var coords []string
+		for i, p := range points {
+			if actualOnly && !p.Actual {
+				break
+			}
+			coords = append(coords, fmt.Sprintf("%.1f,%.1f", x(i), y(value(p))))
+		}
+		if len(coords) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, `<polyline points="%s" fill="none" stroke="%s" stroke-width="2"%s/>`+"\n", strings.Join(coords, " "), color, dash)
+	}
+	line("#999", ` stroke-dasharray="6 4"`, func(p BurndownPoint) time.Duration { return p.Ideal }, false)
+	line("#e69f00", "", func(p BurndownPoint) time.Duration { return p.Scope }, true)
+	line("#0072b2", "", func(p BurndownPoint) time.Duration { return p.Remaining }, true)
+
+	for i, entry := range []struct{ label, color string }{{"Remaining", "#0072b2"}, {"Ideal", "#999"}, {"Scope", "#e69f00"}} {
+		lx := svgLeft + float64(i)*120
+		fmt.Fprintf(&b, `<line x1="%g" y1="%g" x2="%g" y2="%g" stroke="%s" stroke-width="2"/>`+"\n", lx, svgBottom+38, lx+20, svgBottom+38, entry.color)
+		fmt.Fprintf(&b, `<text x="%g" y="%g">%s</text>`+"\n", lx+26, svgBottom+42, entry.label)
+	}
+	b.WriteString("</svg>")
+	return b.String()
+}
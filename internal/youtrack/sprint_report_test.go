@@ -0,0 +1,156 @@
+package youtrack
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarkdownEscaper(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"plain text", "plain text"},
+		{"a | b", `a \| b`},
+		{"*bold* _it_ ~del~", `\*bold\* \_it\_ \~del\~`},
+		{"[link](http://x)", `\[link\](http://x)`},
+		{"`code` C:\\tmp", "\\`code\\` C:\\\\tmp"},
+		{"<script>&", "&lt;script&gt;&amp;"},
+		{"two\nlines\r\nhere", "two lines here"},
+	}
+	for _, tt := range tests {
+		if got := markdownEscaper.Replace(tt.in); got != tt.want {
+			t.Errorf("markdownEscaper(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestWriteSprintReportMarkdownEscapes(t *testing.T) {
+	r := SprintReport{
+		Board:     "crm",
+		Sprint:    Sprint{Name: "Sprint_26", Goal: "Ship <b>login</b> *fast*"},
+		Completed: []Issue{{ID: "CRM-1", Summary: "Fix | pipe and [link](x)"}},
+	}
+	var b strings.Builder
+	WriteSprintReportMarkdown(&b, r)
+	out := b.String()
+
+	for _, want := range []string{
+		"# Sprint review: Sprint\\_26",
+		"**Goal:** Ship &lt;b&gt;login&lt;/b&gt; \\*fast\\*",
+		"| CRM-1 | Fix \\| pipe and \\[link\\](x) |",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("markdown report does not contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestSplitCompleted(t *testing.T) {
+	at := func(d, h int) time.Time { return time.Date(2026, 10, d, h, 0, 0, 0, time.Local) }
+	sprint := Sprint{Name: "Sprint 26", Start: at(5, 9).UnixMilli(), Finish: at(9, 18).UnixMilli()}
+	histories := []issueHistory{
+		{Issue: estimated("DP-1", "4h", at(8, 15))},                                     // Resolved during the sprint
+		{Issue: estimated("DP-2", "2h", at(9, 18))},                                     // Resolved at the finish
+		{Issue: estimated("DP-3", "2h", at(10, 9))},                                     // Resolved after the sprint
+		{Issue: estimated("DP-4", "1h", time.Time{})},                                   // Still open
+		{Issue: estimated("DP-5", "3h", at(7, 9)), RemovedAt: at(6, 12).UnixMilli()},    // Removed, then resolved
+		{Issue: estimated("DP-6", "3h", time.Time{}), RemovedAt: at(7, 12).UnixMilli()}, // Removed and open
+	}
+
+	completed, notCompleted := splitCompleted(sprint, histories)
+	ids := func(issues []Issue) []string {
+		var ids []string
+		for _, iss := range issues {
+			ids = append(ids, iss.ID)
+		}
+		return ids
+	}
+	if got, want := ids(completed), []string{"DP-1", "DP-2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("splitCompleted completed = %v, want %v", got, want)
+	}
+	if got, want := ids(notCompleted), []string{"DP-3", "DP-4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("splitCompleted not completed = %v, want %v", got, want)
+	}
+}
+
+func TestSprintContributions(t *testing.T) {
+	assigned := func(id, estimation, assignee string) Issue {
+		iss := estimated(id, estimation, time.Time{})
+		if assignee != "" {
+			iss.CustomFields = append(iss.CustomFields, CustomField{Name: "Assignee", Value: map[string]interface{}{"fullName": assignee}})
+		}
+		return iss
+	}
+	logged := func(minutes int, login, fullName string) WorkItem {
+		return WorkItem{Duration: Duration{Minutes: minutes}, Author: Author{Login: login, FullName: fullName}}
+	}
+	completed := []Issue{
+		assigned("DP-1", "4h", "Ann Lee"),
+		assigned("DP-2", "2h", "Bo Chen"),
+		assigned("DP-3", "2h", "Bo Chen"),
+		assigned("DP-4", "1h", ""),
+	}
+	items := []WorkItem{
+		logged(120, "ann", "Ann Lee"),
+		logged(60, "bo", "Bo Chen"),
+		logged(90, "cy", ""),     // Logged time without completed issues, credited by login
+		logged(30, "dee", "Dee"), // Ties with cy on completed estimation, less logged
+		logged(30, "eve", "Eve"), // Ties with Dee on both, ordered by name
+		logged(180, "ann", "Ann Lee"),
+	}
+
+	got := sprintContributions(completed, items)
+	h := time.Hour
+	want := []Contribution{
+		{Person: "Ann Lee", Resolved: 1, Completed: 4 * h, Logged: 5 * h},
+		{Person: "Bo Chen", Resolved: 2, Completed: 4 * h, Logged: 1 * h},
+		{Person: "unassigned", Resolved: 1, Completed: 1 * h},
+		{Person: "cy", Logged: 90 * time.Minute},
+		{Person: "Dee", Logged: 30 * time.Minute},
+		{Person: "Eve", Logged: 30 * time.Minute},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("sprintContributions = %+v, want %+v", got, want)
+	}
+}
+
+func TestBurndownSVG(t *testing.T) {
+	if got := BurndownSVG(nil); !strings.Contains(got, "No burndown data") || strings.Contains(got, "<polyline") {
+		t.Errorf("BurndownSVG(nil) = %q, want a chart saying there is no data", got)
+	}
+
+	day0 := time.Date(2026, 10, 5, 0, 0, 0, 0, time.Local)
+	h := time.Hour
+	points := []BurndownPoint{
+		{Date: day0, Scope: 8 * h, Remaining: 8 * h, Ideal: 8 * h, Actual: true},
+		{Date: day0.AddDate(0, 0, 1), Scope: 8 * h, Remaining: 4 * h, Ideal: 4 * h, Actual: true},
+		{Date: day0.AddDate(0, 0, 2), Scope: 8 * h, Remaining: 4 * h, Ideal: 0},
+	}
+	svg := BurndownSVG(points)
+	if !strings.HasPrefix(svg, "<svg ") || !strings.HasSuffix(svg, "</svg>") {
+		t.Errorf("BurndownSVG = %q, want a single svg element", svg)
+	}
+	if strings.Contains(svg, "\n\n") {
+		t.Errorf("BurndownSVG contains a blank line, which ends a Markdown HTML block")
+	}
+
+	// Ideal covers every day, scope and remaining stop at the last actual day.
+	var polylines []int
+	for _, l := range strings.Split(svg, "\n") {
+		if strings.HasPrefix(l, "<polyline ") {
+			start := strings.Index(l, `points="`) + len(`points="`)
+			coords := l[start : start+strings.Index(l[start:], `"`)]
+			polylines = append(polylines, len(strings.Fields(coords)))
+		}
+	}
+	if want := []int{3, 2, 2}; !reflect.DeepEqual(polylines, want) {
+		t.Errorf("BurndownSVG polyline lengths = %v, want %v", polylines, want)
+	}
+	for _, label := range []string{">10-05<", ">10-07<", ">8h<", ">0h<", ">Remaining<", ">Ideal<", ">Scope<"} {
+		if !strings.Contains(svg, label) {
+			t.Errorf("BurndownSVG is missing %s", label)
+		}
+	}
+}
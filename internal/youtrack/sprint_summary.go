@@ -0,0 +1,133 @@
+package youtrack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// SprintSummary holds the figures shown by `sprint show`.
+type SprintSummary struct {
+	Sprint        Sprint
+	IssueCount    int
+	StateCounts   map[string]int
+	Estimation    time.Duration
+	Spent         time.Duration
+	AssigneeLoads []AssigneeLoad
+}
+
+// AssigneeLoad is the share of a sprint assigned to one person.
+type AssigneeLoad struct {
+	Assignee   string
+	Issues     int
+	Estimation time.Duration
+	Spent      time.Duration
+}
+
+// SummarizeSprint aggregates the issues of a sprint per state and per assignee.
+func SummarizeSprint(sprint Sprint, issues []Issue) SprintSummary {
+	summary := SprintSummary{
+		Sprint:      sprint,
+		IssueCount:  len(issues),
+		StateCounts: make(map[string]int),
+		Estimation:  SumEstimation(issues),
+		Spent:       SumSpentTime(issues),
+	}
+
+	loads := make(map[string]*AssigneeLoad)
+	for _, iss := range issues {
+		state := IssueField(iss, "State")
+		if state == "" {
+			state = "N/A"
+		}
+		summary.StateCounts[state]++
+
+		assignee := IssueAssignee(iss)
+		load, ok := loads[assignee]
+		if !ok {
+			load = &AssigneeLoad{Assignee: assignee}
+			loads[assignee] = load
+		}
+		load.Issues++
+		load.Estimation += SumEstimation([]Issue{iss})
+		load.Spent += SumSpentTime([]Issue{iss})
+	}
+
+	for _, load := range loads {
+		summary.AssigneeLoads = append(summary.AssigneeLoads, *load)
+	}
+	sort.Slice(summary.AssigneeLoads, func(i, j int) bool {
+		if summary.AssigneeLoads[i].Estimation != summary.AssigneeLoads[j].Estimation {
+			return summary.AssigneeLoads[i].Estimation > summary.AssigneeLoads[j].Estimation
+		}
+		return summary.AssigneeLoads[i].Assignee < summary.AssigneeLoads[j].Assignee
+	})
+
+	return summary
+}
+
+// sprintTimeline describes where now falls relative to the sprint dates.
+func sprintTimeline(sprint Sprint, now time.Time) string {
+	if sprint.Start == 0 || sprint.Finish == 0 {
+		return "no dates set"
+	}
+
+	start := unixMilliToTime(sprint.Start)
+	finish := unixMilliToTime(sprint.Finish)
+	switch {
+	case now.Before(start):
+		return fmt.Sprintf("starts in %d day(s)", daysBetween(now, start))
+	case now.After(finish):
+		return "finished"
+	default:
+		return fmt.Sprintf("%d day(s) remaining", daysBetween(now, finish))
+	}
+}
+
+// daysBetween returns the number of days from a to b, rounded up.
+func daysBetween(a, b time.Time) int {
+	return int(math.Ceil(b.Sub(a).Hours() / 24))
+}
+
+// formatSprintDate formats a sprint timestamp, or "N/A" when unset.
+func formatSprintDate(ms int64) string {
+	if ms == 0 {
+		return "N/A"
+	}
+	return unixMilliToTime(ms).Format("2006-01-02")
+}
+
+// PrintSprintSummary prints the sprint overview used during standups.
+func PrintSprintSummary(boardName string, summary SprintSummary) {
+	sprint := summary.Sprint
+	fmt.Printf("Sprint '%s' (board '%s')\n", sprint.Name, boardName)
+	fmt.Printf("Dates:     %s → %s (%s)\n", formatSprintDate(sprint.Start), formatSprintDate(sprint.Finish), sprintTimeline(sprint, time.Now()))
+	if sprint.Goal != "" {
+		fmt.Printf("Goal:      %s\n", sprint.Goal)
+	}
+	fmt.Printf("Issues:    %d\n", summary.IssueCount)
+	fmt.Printf("Estimated: %s\n", HumanizeDuration(summary.Estimation))
+	fmt.Printf("Spent:     %s", HumanizeDuration(summary.Spent))
+	if summary.Estimation > 0 {
+		fmt.Printf(" (%.0f%% of estimation)", float64(summary.Spent)/float64(summary.Estimation)*100)
+	}
+	fmt.Println()
+
+	states := make([]string, 0, len(summary.StateCounts))
+	for state := range summary.StateCounts {
+		states = append(states, state)
+	}
+	sort.Strings(states)
+
+	fmt.Println("\nBy state:")
+	for _, state := range states {
+		fmt.Printf("  %-20s\t%d\n", state, summary.StateCounts[state])
+	}
+
+	fmt.Println("\nBy assignee:")
+	fmt.Printf("  %-20s\t%-6s\t%-12s\t%s\n", "Assignee", "Issues", "Estimation", "Spent Time")
+	for _, load := range summary.AssigneeLoads {
+		fmt.Printf("  %-20s\t%-6d\t%-12s\t%s\n", load.Assignee, load.Issues, HumanizeDuration(load.Estimation), HumanizeDuration(load.Spent))
+	}
+}
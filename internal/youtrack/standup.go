@@ -0,0 +1,236 @@
+package youtrack
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+// StandupEntry is what happened on one issue since the last standup.
+type StandupEntry struct {
+	IssueID     string
+	Summary     string
+	Logged      time.Duration
+	Transitions []string // State changes, e.g. "Open → In Progress"
+	Comments    []string // First line of each comment written by the user
+}
+
+// Standup collects the material for a standup summary.
+type Standup struct {
+	Date     time.Time // Day of the standup
+	Since    time.Time
+	Done     []StandupEntry
+	Today    []Issue // Issues assigned to the user that are in progress
+	Blockers []Issue // Issues assigned to the user that are blocked
+}
+
+// standupIssue is an issue together with the State changes or comments fetched for a standup.
+type standupIssue struct {
+	Issue      Issue
+	Activities []Activity
+	Comments   []Comment
+}
+
+// BuildStandup collects the work the current user logged since the given time, State changes
+// of their issues, comments they wrote, and their issues that are in progress or blocked.
+func BuildStandup(cfg config.Config, since time.Time, progressStates, blockedStates []string) (Standup, error) {
+	now := time.Now()
+	standup := Standup{Date: now, Since: since}
+	user, err := CurrentUser(cfg)
+	if err != nil {
+		return standup, fmt.Errorf("failed to fetch current user: %w", err)
+	}
+
+	items, err := FetchWorkItems(cfg, WorkItemFilter{Since: since, Until: now, Author: user.Login})
+	if err != nil {
+		return standup, fmt.Errorf("failed to fetch work items: %w", err)
+	}
+
+	var issues []standupIssue
+	sinceQuery := "updated: " + since.Format("2006-01-02") + " .. Today"
+	mine, err := SearchIssues(cfg, "for:me "+sinceQuery)
+	if err != nil {
+		return standup, fmt.Errorf("failed to fetch issues: %w", err)
+	}
+	for _, iss := range mine {
+		activities, err := FetchIssueActivities(cfg, iss.ID, "CustomFieldCategory")
+		if err != nil {
+			return standup, fmt.Errorf("failed to fetch activities of %s: %w", iss.ID, err)
+		}
+		issues = append(issues, standupIssue{Issue: iss, Activities: activities})
+	}
+
+	commented, err := SearchIssues(cfg, "commenter: me "+sinceQuery)
+	if err != nil {
+		return standup, fmt.Errorf("failed to fetch commented issues: %w", err)
+	}
+	for _, iss := range commented {
+		comments, err := fetchComments(cfg, iss.ID)
+		if err != nil {
+			return standup, fmt.Errorf("failed to fetch comments of %s: %w", iss.ID, err)
+		}
+		issues = append(issues, standupIssue{Issue: iss, Comments: comments})
+	}
+	standup.Done = groupStandup(since, user.Login, items, issues)
+
+	open, err := SearchIssues(cfg, "for:me #Unresolved")
+	if err != nil {
+		return standup, fmt.Errorf("failed to fetch open issues: %w", err)
+	}
+	standup.Today, standup.Blockers = splitOpenIssues(open, progressStates, blockedStates)
+	return standup, nil
+}
+
+// groupStandup merges the time logged, State changes and the user's comments since the given
+// time into one entry per issue, ordered by time logged, then issue ID.
+func groupStandup(since time.Time, login string, items []WorkItem, issues []standupIssue) []StandupEntry {
+	entries := make(map[string]*StandupEntry)
+	entry := func(id, summary string) *StandupEntry {
+		e, ok := entries[id]
+		if !ok {
+			e = &StandupEntry{IssueID: id}
+			entries[id] = e
+		}
+		if summary != "" {
+			e.Summary = summary
+		}
+		return e
+	}
+
+	for _, item := range items {
+		summary := ""
+		if item.Issue != nil {
+			summary = item.Issue.Summary
+		}
+		entry(workItemIssueID(item), summary).Logged += WorkItemDuration(item)
+	}
+
+	for _, si := range issues {
+		for _, a := range fieldChanges(si.Activities, "State") {
+			if unixMilliToTime(a.Timestamp).Before(since) {
+				continue
+			}
+			e := entry(si.Issue.ID, si.Issue.Summary)
+			e.Transitions = append(e.Transitions, fmt.Sprintf("%s → %s", orNA(activityValue(a.Removed)), activityValue(a.Added)))
+		}
+		for _, c := range si.Comments {
+			if c.Author.Login != login || unixMilliToTime(c.Created).Before(since) {
+				continue
+			}
+			line, _, _ := strings.Cut(strings.TrimSpace(c.Text), "\n")
+			e := entry(si.Issue.ID, si.Issue.Summary)
+			e.Comments = append(e.Comments, line)
+		}
+	}
+
+	var done []StandupEntry
+	for _, e := range entries {
+		done = append(done, *e)
+	}
+	sort.Slice(done, func(i, j int) bool {
+		if done[i].Logged != done[j].Logged {
+			return done[i].Logged > done[j].Logged
+		}
+		return done[i].IssueID < done[j].IssueID
+	})
+	return done
+}
+
+// splitOpenIssues picks the issues in one of the progress states and those in a blocked state.
+func splitOpenIssues(open []Issue, progressStates, blockedStates []string) (today, blockers []Issue) {
+	for _, iss := range open {
+		state := IssueField(iss, "State")
+		switch {
+		case containsFold(blockedStates, state):
+			blockers = append(blockers, iss)
+		case containsFold(progressStates, state):
+			today = append(today, iss)
+		}
+	}
+	return today, blockers
+}
+
+// commentPageSize is the number of comments requested per page.
+const commentPageSize = 100
+
+// fetchComments fetches the comments of an issue.
+func fetchComments(cfg config.Config, issueID string) ([]Comment, error) {
+	client := NewClient(cfg)
+
+	params := url.Values{}
+	params.Set("fields", "id,text,created,author(login,fullName)")
+	params.Set("$top", fmt.Sprint(commentPageSize))
+
+	var comments []Comment
+	for skip := 0; ; skip += commentPageSize {
+		params.Set("$skip", fmt.Sprint(skip))
+		var page []Comment
+		if err := client.get(fmt.Sprintf("/api/issues/%s/comments?%s", issueID, params.Encode()), &page); err != nil {
+			return nil, err
+		}
+		comments = append(comments, page...)
+		if len(page) < commentPageSize {
+			break
+		}
+	}
+	return comments, nil
+}
+
+// containsFold reports whether values contains s, ignoring case.
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteStandupMarkdown writes the standup as a ready-to-paste Markdown summary. Text taken
+// from YouTrack is escaped so that it renders literally.
+func WriteStandupMarkdown(w io.Writer, s Standup) {
+	fmt.Fprintf(w, "## Standup %s\n\n", s.Date.Format("2006-01-02"))
+
+	fmt.Fprintf(w, "**Yesterday** (since %s)\n", s.Since.Format("Mon 2006-01-02"))
+	if len(s.Done) == 0 {
+		fmt.Fprintln(w, "- Nothing logged")
+	}
+	for _, e := range s.Done {
+		var details []string
+		if e.Logged > 0 {
+			details = append(details, HumanizeDuration(e.Logged)+" logged")
+		}
+		for _, t := range e.Transitions {
+			details = append(details, markdownEscaper.Replace(t))
+		}
+		for _, c := range e.Comments {
+			details = append(details, fmt.Sprintf("commented: \"%s\"", markdownEscaper.Replace(c)))
+		}
+		fmt.Fprintf(w, "- %s %s", e.IssueID, markdownEscaper.Replace(e.Summary))
+		if len(details) > 0 {
+			fmt.Fprintf(w, " (%s)", strings.Join(details, "; "))
+		}
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintln(w, "\n**Today**")
+	writeStandupIssues(w, s.Today, "Nothing in progress")
+
+	fmt.Fprintln(w, "\n**Blockers**")
+	writeStandupIssues(w, s.Blockers, "None")
+}
+
+// writeStandupIssues writes issues as a Markdown list, or a placeholder when empty.
+func writeStandupIssues(w io.Writer, issues []Issue, empty string) {
+	if len(issues) == 0 {
+		fmt.Fprintf(w, "- %s\n", empty)
+		return
+	}
+	for _, iss := range issues {
+		fmt.Fprintf(w, "- %s %s (%s)\n", iss.ID, markdownEscaper.Replace(iss.Summary), markdownEscaper.Replace(IssueField(iss, "State")))
+	}
+}
@@ -0,0 +1,134 @@
+package youtrack
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// stateIssue returns an issue in the given State.
+func stateIssue(id, summary, state string) Issue {
+	return Issue{ID: id, Summary: summary, CustomFields: []CustomField{{Name: "State", Value: map[string]interface{}{"name": state}}}}
+}
+
+func TestGroupStandup(t *testing.T) {
+	since := time.Date(2026, 10, 16, 0, 0, 0, 0, time.Local)
+	at := func(h int) int64 { return since.Add(time.Duration(h) * time.Hour).UnixMilli() }
+	stateChange := func(ts int64, from, to string) Activity {
+		return Activity{Timestamp: ts, Field: &FieldRef{Name: "State"},
+			Removed: []interface{}{map[string]interface{}{"name": from}}, Added: []interface{}{map[string]interface{}{"name": to}}}
+	}
+	items := []WorkItem{
+		{Date: at(10), Duration: Duration{Minutes: 60}, Issue: &Issue{ID: "DP-1", Summary: "Login page"}},
+		{Date: at(14), Duration: Duration{Minutes: 30}, Issue: &Issue{ID: "DP-1", Summary: "Login page"}},
+		{Date: at(15), Duration: Duration{Minutes: 120}, Issue: &Issue{ID: "DP-2", Summary: "Export"}},
+	}
+	issues := []standupIssue{
+		{Issue: Issue{ID: "DP-1", Summary: "Login page"}, Activities: []Activity{
+			stateChange(at(-20), "Open", "In Progress"), // Before the standup period
+			stateChange(at(16), "In Progress", "Review"),
+			{Timestamp: at(16), Field: &FieldRef{Name: "Priority"}},
+		}},
+		{Issue: Issue{ID: "DP-3", Summary: "Flaky test"}, Comments: []Comment{
+			{Text: "Reproduced on CI\nLog attached", Created: at(11), Author: Author{Login: "jane"}},
+			{Text: "Thanks", Created: at(12), Author: Author{Login: "bob"}},
+			{Text: "Older", Created: at(-2), Author: Author{Login: "jane"}},
+		}},
+		{Issue: Issue{ID: "DP-4", Summary: "Untouched"}, Activities: []Activity{stateChange(at(-30), "Open", "Done")}},
+	}
+
+	got := groupStandup(since, "jane", items, issues)
+	want := []StandupEntry{
+		{IssueID: "DP-2", Summary: "Export", Logged: 2 * time.Hour},
+		{IssueID: "DP-1", Summary: "Login page", Logged: 90 * time.Minute, Transitions: []string{"In Progress → Review"}},
+		{IssueID: "DP-3", Summary: "Flaky test", Comments: []string{"Reproduced on CI"}},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("groupStandup = %+v, want %+v", got, want)
+	}
+	for i := range got {
+		if got[i].IssueID != want[i].IssueID || got[i].Summary != want[i].Summary || got[i].Logged != want[i].Logged ||
+			!slices.Equal(got[i].Transitions, want[i].Transitions) || !slices.Equal(got[i].Comments, want[i].Comments) {
+			t.Errorf("groupStandup entry %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSplitOpenIssues(t *testing.T) {
+	open := []Issue{
+		stateIssue("DP-1", "A", "In Progress"),
+		stateIssue("DP-2", "B", "blocked"),
+		stateIssue("DP-3", "C", "Open"),
+		stateIssue("DP-4", "D", "review"),
+	}
+	today, blockers := splitOpenIssues(open, []string{"In Progress", "Review"}, []string{"Blocked"})
+	var todayIDs, blockerIDs []string
+	for _, iss := range today {
+		todayIDs = append(todayIDs, iss.ID)
+	}
+	for _, iss := range blockers {
+		blockerIDs = append(blockerIDs, iss.ID)
+	}
+	if !slices.Equal(todayIDs, []string{"DP-1", "DP-4"}) || !slices.Equal(blockerIDs, []string{"DP-2"}) {
+		t.Errorf("splitOpenIssues = %v, %v, want [DP-1 DP-4], [DP-2]", todayIDs, blockerIDs)
+	}
+}
+
+func TestWriteStandupMarkdown(t *testing.T) {
+	date := time.Date(2026, 10, 19, 9, 0, 0, 0, time.Local)
+	tests := []struct {
+		name    string
+		standup Standup
+		want    string
+	}{
+		{
+			name:    "empty",
+			standup: Standup{Date: date, Since: date.AddDate(0, 0, -3)},
+			want: `## Standup 2026-10-19
+
+**Yesterday** (since Fri 2026-10-16)
+- Nothing logged
+
+**Today**
+- Nothing in progress
+
+**Blockers**
+- None
+`,
+		},
+		{
+			name: "escaped",
+			standup: Standup{
+				Date:  date,
+				Since: date.AddDate(0, 0, -3),
+				Done: []StandupEntry{
+					{IssueID: "DP-1", Summary: "Fix *bold* <b>tags</b>", Logged: 90 * time.Minute, Transitions: []string{"Open → In_Progress"},
+						Comments: []string{"see [link](x) & `code`"}},
+					{IssueID: "DP-2", Summary: "Plain", Comments: []string{"Done"}},
+				},
+				Today:    []Issue{stateIssue("DP-3", "a|b", "In Progress")},
+				Blockers: []Issue{stateIssue("DP-4", "Waiting on _ops_", "Blocked")},
+			},
+			want: `## Standup 2026-10-19
+
+**Yesterday** (since Fri 2026-10-16)
+- DP-1 Fix \*bold\* &lt;b&gt;tags&lt;/b&gt; (1h 30m logged; Open → In\_Progress; commented: "see \[link\](x) &amp; \` + "`" + `code\` + "`" + `")
+- DP-2 Plain (commented: "Done")
+
+**Today**
+- DP-3 a\|b (In Progress)
+
+**Blockers**
+- DP-4 Waiting on \_ops\_ (Blocked)
+`,
+		},
+	}
+	for _, tt := range tests {
+		var b strings.Builder
+		WriteStandupMarkdown(&b, tt.standup)
+		if got := b.String(); got != tt.want {
+			t.Errorf("WriteStandupMarkdown(%s) =\n%s\nwant\n%s", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package youtrack
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+// durationPattern matches one or more "<number><unit>" parts, e.g. "1h30m", "1.5h", "2d 4h".
+var durationPattern = regexp.MustCompile(`^(\s*\d+(\.\d+)?\s*[dhm])+\s*$`)
+
+// durationPart matches a single "<number><unit>" part.
+var durationPart = regexp.MustCompile(`(\d+(?:\.\d+)?)\s*([dhm])`)
+
+// ParseDuration parses a work duration such as "90", "45m", "1h30m", "1.5h" or "2d".
+// A plain number is taken as minutes and a day is hoursPerDay hours long.
+func ParseDuration(s string, hoursPerDay float64) (time.Duration, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if minutes, err := strconv.Atoi(s); err == nil {
+		if minutes <= 0 {
+			return 0, fmt.Errorf("invalid duration '%s': must be positive", s)
+		}
+		return time.Duration(minutes) * time.Minute, nil
+	}
+
+	if !durationPattern.MatchString(s) {
+		return 0, fmt.Errorf("invalid duration '%s' (use e.g. 90, 45m, 1h30m, 1.5h or 2d)", s)
+	}
+
+	var minutes float64
+	for _, match := range durationPart.FindAllStringSubmatch(s, -1) {
+		value, _ := strconv.ParseFloat(match[1], 64)
+		switch match[2] {
+		case "d":
+			minutes += value * hoursPerDay * 60
+		case "h":
+			minutes += value * 60
+		case "m":
+			minutes += value
+		}
+	}
+
+	d := time.Duration(math.Round(minutes)) * time.Minute
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid duration '%s': must be at least one minute", s)
+	}
+	return d, nil
+}
+
+// ParseWorkDuration parses a work duration, looking up the length of a working day only
+// when the input uses days. The warning is non-empty when the day length had to fall back
+// to the config (see WorkDayHours).
+func ParseWorkDuration(cfg config.Config, s string) (d time.Duration, warning string, err error) {
+	hoursPerDay := cfg.Capacity.HoursPerDayFor("")
+	if DurationUsesDays(s) {
+		hoursPerDay, warning = WorkDayHours(cfg)
+	}
+	d, err = ParseDuration(s, hoursPerDay)
+	return d, warning, err
+}
+
+// WorkDayHours returns the length of a working day in hours. YouTrack defines it once for
+// the whole server in the time tracking settings, not per project. When those settings
+// cannot be read (reading them may require admin rights) hours_per_day from the config
+// (default 6) is used and a warning saying so is returned.
+func WorkDayHours(cfg config.Config) (float64, string) {
+	h, err := WorkHoursPerDay(cfg)
+	if err == nil {
+		return h, ""
+	}
+	fallback := cfg.Capacity.HoursPerDayFor("")
+	return fallback, fmt.Sprintf("could not read the length of a working day from YouTrack (%v), counting 1d as %gh from hours_per_day", err, fallback)
+}
+
+// DurationUsesDays reports whether a valid duration string has a day part,
+// in which case the length of a working day is needed to parse it.
+func DurationUsesDays(s string) bool {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if !durationPattern.MatchString(s) {
+		return false
+	}
+	for _, match := range durationPart.FindAllStringSubmatch(s, -1) {
+		if match[2] == "d" {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseDate parses a day given as YYYY-MM-DD, "today" or "yesterday" in local time.
+func ParseDate(s string, now time.Time) (time.Time, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "today":
+		return startOfDay(now), nil
+	case "yesterday":
+		return startOfDay(now).AddDate(0, 0, -1), nil
+	}
+
+	t, err := time.ParseInLocation("2006-01-02", strings.TrimSpace(s), now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date '%s' (use YYYY-MM-DD, today or yesterday)", s)
+	}
+	return t, nil
+}
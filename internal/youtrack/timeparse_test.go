@@ -0,0 +1,91 @@
+package youtrack
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{in: "90", want: 90 * time.Minute},
+		{in: "45m", want: 45 * time.Minute},
+		{in: "1h30m", want: 90 * time.Minute},
+		{in: "1.5h", want: 90 * time.Minute},
+		{in: " 2H 15M ", want: 135 * time.Minute},
+		{in: "2d", want: 16 * time.Hour}, // 8h days
+		{in: "1d 4h", want: 12 * time.Hour},
+		{in: "0.5d", want: 4 * time.Hour},
+		{in: "0.01m", wantErr: true}, // Rounds to zero
+		{in: "0", wantErr: true},
+		{in: "-5", wantErr: true},
+		{in: "", wantErr: true},
+		{in: "1w", wantErr: true},
+		{in: "1h30", wantErr: true},
+		{in: "abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.in, 8)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseDuration(%q) = %v, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseDuration(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestDurationUsesDays(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"2d", true},
+		{"1D 4h", true},
+		{"0.5d", true},
+		{"1h30m", false},
+		{"90", false},
+		{"add docs", false}, // Not a duration even though it contains a "d"
+		{"2dx", false},
+	}
+	for _, tt := range tests {
+		if got := DurationUsesDays(tt.in); got != tt.want {
+			t.Errorf("DurationUsesDays(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, time.Local)
+	tests := []struct {
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{in: "today", want: time.Date(2026, 10, 14, 0, 0, 0, 0, time.Local)},
+		{in: "Yesterday", want: time.Date(2026, 10, 13, 0, 0, 0, 0, time.Local)},
+		{in: "2026-10-01", want: time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)},
+		{in: " 2026-01-31 ", want: time.Date(2026, 1, 31, 0, 0, 0, 0, time.Local)},
+		{in: "2026-02-30", wantErr: true},
+		{in: "14/10/2026", wantErr: true},
+		{in: "tomorrow", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDate(tt.in, now)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("ParseDate(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
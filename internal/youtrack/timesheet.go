@@ -0,0 +1,177 @@
+package youtrack
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+// isoWeekPattern matches an ISO week such as "2026-W42".
+var isoWeekPattern = regexp.MustCompile(`^(\d{4})-?[Ww](\d{1,2})$`)
+
+// Timesheet is a week of logged time laid out per issue and day.
+type Timesheet struct {
+	Days      []time.Time                // Monday to Sunday, local midnight
+	Issues    []string                   // Issue IDs, ordered by total time
+	Summaries map[string]string          // Issue summaries keyed by ID
+	Cells     map[string][]time.Duration // Logged time per issue, indexed like Days
+	Totals    []time.Duration            // Logged time per day
+	Target    time.Duration              // Target per workday
+	Workdays  []bool                     // Whether each day counts against the target
+	Today     bool                       // Whether today is checked against the target
+}
+
+// ParseISOWeek returns the local Monday starting an ISO week given as "2026-W42".
+// An empty string selects the week containing now.
+func ParseISOWeek(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return weekStart(now), nil
+	}
+
+	match := isoWeekPattern.FindStringSubmatch(s)
+	if match == nil {
+		return time.Time{}, fmt.Errorf("invalid week '%s' (use e.g. 2026-W42)", s)
+	}
+	year, _ := strconv.Atoi(match[1])
+	week, _ := strconv.Atoi(match[2])
+
+	// January 4th is always in week 1
+	monday := weekStart(time.Date(year, time.January, 4, 0, 0, 0, 0, now.Location())).AddDate(0, 0, (week-1)*7)
+	if y, w := monday.ISOWeek(); week < 1 || y != year || w != week {
+		return time.Time{}, fmt.Errorf("invalid week '%s': %d has no week %d", s, year, week)
+	}
+	return monday, nil
+}
+
+// weekStart returns local midnight of the Monday of the week containing t.
+func weekStart(t time.Time) time.Time {
+	day := startOfDay(t)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// BuildTimesheet lays out work items of the week starting on monday. Like 'work check', days off
+// configured for the named person under capacity do not count against the target.
+func BuildTimesheet(cfg config.Config, items []WorkItem, monday time.Time, name string) Timesheet {
+	ts := Timesheet{
+		Summaries: make(map[string]string),
+		Cells:     make(map[string][]time.Duration),
+		Totals:    make([]time.Duration, 7),
+		Target:    cfg.DailyTarget(),
+	}
+	dayIndex := make(map[string]int)
+	for i := 0; i < 7; i++ {
+		day := monday.AddDate(0, 0, i)
+		ts.Days = append(ts.Days, day)
+		ts.Workdays = append(ts.Workdays, cfg.IsWorkday(day) && !cfg.Capacity.IsDayOff(name, day))
+		dayIndex[day.Format("2006-01-02")] = i
+	}
+
+	issueTotals := make(map[string]time.Duration)
+	for _, item := range items {
+		index, ok := dayIndex[workItemDay(item).Format("2006-01-02")]
+		if !ok {
+			continue
+		}
+		issueID := workItemIssueID(item)
+		if _, ok := ts.Cells[issueID]; !ok {
+			ts.Cells[issueID] = make([]time.Duration, 7)
+			ts.Issues = append(ts.Issues, issueID)
+		}
+		if item.Issue != nil {
+			ts.Summaries[issueID] = item.Issue.Summary
+		}
+
+		d := WorkItemDuration(item)
+		ts.Cells[issueID][index] += d
+		ts.Totals[index] += d
+		issueTotals[issueID] += d
+	}
+
+	sort.Slice(ts.Issues, func(i, j int) bool {
+		if issueTotals[ts.Issues[i]] != issueTotals[ts.Issues[j]] {
+			return issueTotals[ts.Issues[i]] > issueTotals[ts.Issues[j]]
+		}
+		return ts.Issues[i] < ts.Issues[j]
+	})
+	return ts
+}
+
+// UnderTarget returns the indexes of completed workdays with less time logged than the target.
+// Today only counts when ts.Today is set, since it is usually not finished yet.
+func (ts Timesheet) UnderTarget(now time.Time) []int {
+	last := startOfDay(now)
+	if ts.Today {
+		last = last.AddDate(0, 0, 1)
+	}
+	var under []int
+	for i, day := range ts.Days {
+		if ts.Workdays[i] && day.Before(last) && ts.Totals[i] < ts.Target {
+			under = append(under, i)
+		}
+	}
+	return under
+}
+
+// PrintTimesheet prints the day-by-issue grid with daily totals against the target,
+// flagging workdays under target with ⚠.
+func PrintTimesheet(ts Timesheet, now time.Time) {
+	year, week := ts.Days[0].ISOWeek()
+	fmt.Printf("Timesheet %d-W%02d (%s - %s), target %s per workday\n\n", year, week,
+		ts.Days[0].Format("2006-01-02"), ts.Days[6].Format("2006-01-02"), HumanizeDuration(ts.Target))
+
+	fmt.Printf("%-12s", "Issue")
+	for _, day := range ts.Days {
+		fmt.Printf("\t%-9s", day.Format("Mon 01/02"))
+	}
+	fmt.Printf("\t%-8s\t%s\n", "Total", "Summary")
+
+	var weekTotal time.Duration
+	for _, id := range ts.Issues {
+		var total time.Duration
+		fmt.Printf("%-12s", id)
+		for _, d := range ts.Cells[id] {
+			fmt.Printf("\t%-9s", timesheetCell(d))
+			total += d
+		}
+		fmt.Printf("\t%-8s\t%s\n", HumanizeDuration(total), ts.Summaries[id])
+		weekTotal += total
+	}
+
+	under := make(map[int]bool)
+	for _, i := range ts.UnderTarget(now) {
+		under[i] = true
+	}
+	fmt.Printf("%-12s", "Total")
+	for i, d := range ts.Totals {
+		cell := timesheetCell(d)
+		if under[i] {
+			cell += " ⚠"
+		}
+		fmt.Printf("\t%-9s", cell)
+	}
+	fmt.Printf("\t%s\n", HumanizeDuration(weekTotal))
+
+	if len(under) == 0 {
+		fmt.Println("\nAll completed workdays meet the target.")
+		return
+	}
+	fmt.Println("\n⚠ Workdays under target:")
+	for _, i := range ts.UnderTarget(now) {
+		fmt.Printf("  %s\t%s logged, %s missing\n", ts.Days[i].Format("Mon 2006-01-02"),
+			HumanizeDuration(ts.Totals[i]), HumanizeDuration(ts.Target-ts.Totals[i]))
+	}
+}
+
+// timesheetCell formats a logged duration, showing "-" for nothing logged.
+func timesheetCell(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	return HumanizeDuration(d)
+}
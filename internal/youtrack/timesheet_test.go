@@ -0,0 +1,83 @@
+package youtrack
+
+import (
+	"slices"
+	"testing"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+func TestParseISOWeek(t *testing.T) {
+	now := time.Date(2026, 10, 18, 20, 0, 0, 0, time.Local) // Sunday of 2026-W42
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.Local) }
+	tests := []struct {
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{in: "", want: day(2026, 10, 12)},
+		{in: "2026-W42", want: day(2026, 10, 12)},
+		{in: "2026w42", want: day(2026, 10, 12)},
+		{in: "2026-W01", want: day(2025, 12, 29)}, // Week 1 starts in the previous year
+		{in: "2020-W53", want: day(2020, 12, 28)},
+		{in: "2021-W53", wantErr: true}, // 2021 has 52 weeks
+		{in: "2026-W00", wantErr: true},
+		{in: "2026-42", wantErr: true},
+		{in: "W42", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseISOWeek(tt.in, now)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseISOWeek(%q) = %v, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("ParseISOWeek(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestWeekStart(t *testing.T) {
+	monday := time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local)
+	for i := 0; i < 7; i++ {
+		at := monday.AddDate(0, 0, i).Add(23 * time.Hour)
+		if got := weekStart(at); !got.Equal(monday) {
+			t.Errorf("weekStart(%v) = %v, want %v", at, got, monday)
+		}
+	}
+}
+
+func TestTimesheetUnderTarget(t *testing.T) {
+	monday := time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local)
+	cfg := config.Config{DailyHours: 8, Capacity: config.Capacity{People: map[string]config.Person{
+		"Jane Doe": {DaysOff: []string{"2026-10-13"}},
+	}}}
+	logged := func(d int, hours int) WorkItem {
+		return WorkItem{Date: monday.AddDate(0, 0, d).Add(10 * time.Hour).UnixMilli(), Duration: Duration{Minutes: hours * 60},
+			Issue: &Issue{ID: "DP-1"}}
+	}
+	// Monday meets the target, nothing is logged from Tuesday on
+	items := []WorkItem{logged(0, 8), logged(2, 3)}
+	thursday := monday.AddDate(0, 0, 3).Add(15 * time.Hour)
+
+	tests := []struct {
+		name  string
+		today bool
+		now   time.Time
+		want  []int
+	}{
+		{"day off and today skipped", false, thursday, []int{2}},
+		{"today included", true, thursday, []int{2, 3}},
+		{"weekend never flagged", false, monday.AddDate(0, 0, 8), []int{2, 3, 4}},
+		{"start of week", false, monday.Add(9 * time.Hour), nil},
+	}
+	for _, tt := range tests {
+		ts := BuildTimesheet(cfg, items, monday, "Jane Doe")
+		ts.Today = tt.today
+		if got := ts.UnderTarget(tt.now); !slices.Equal(got, tt.want) {
+			t.Errorf("UnderTarget(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
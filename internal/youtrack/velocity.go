@@ -0,0 +1,151 @@
+package youtrack
+
+import (
+	"fmt"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+// SprintVelocity holds what was committed to and completed in a closed sprint.
+type SprintVelocity struct {
+	Sprint          Sprint
+	Committed       time.Duration // Estimation of all issues in the sprint
+	Completed       time.Duration // Estimation of issues resolved by the sprint finish
+	TotalIssues     int
+	CompletedIssues int
+}
+
+// VelocitySummary aggregates velocity across sprints.
+type VelocitySummary struct {
+	Average   time.Duration
+	Trend     time.Duration // Change of completed estimation per sprint (least squares slope)
+	Suggested time.Duration // Capacity suggestion for the next sprint
+}
+
+// suggestionWindow is the number of most recent sprints the capacity suggestion is based on.
+const suggestionWindow = 3
+
+// BuildVelocity computes the velocity of the last closed sprints of a board, oldest first.
+func BuildVelocity(cfg config.Config, board AgileBoard, last int) ([]SprintVelocity, error) {
+	sprints, err := ListBoardSprints(cfg, board)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sprints for board '%s': %w", board.Name, err)
+	}
+
+	closed := closedSprints(board, sprints, time.Now(), last)
+	if len(closed) == 0 {
+		return nil, fmt.Errorf("no closed sprints found for board '%s'", board.Name)
+	}
+
+	var velocities []SprintVelocity
+	for _, sprint := range closed {
+		issues, err := FetchSprintIssues(cfg, board, sprint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch issues for sprint '%s': %w", sprint.Name, err)
+		}
+		velocities = append(velocities, sprintVelocity(sprint, issues))
+	}
+	return velocities, nil
+}
+
+// closedSprints returns up to last sprints that finished before now, oldest first.
+// The board's current sprint is never considered closed.
+func closedSprints(board AgileBoard, sprints []Sprint, now time.Time, last int) []Sprint {
+	sortSprints(sprints)
+	current := currentSprintIndex(board, sprints, now)
+
+	var closed []Sprint
+	for i, s := range sprints {
+		if i == current {
+			continue
+		}
+		if s.Finish > 0 && s.Finish < now.UnixMilli() {
+			closed = append(closed, s)
+		}
+	}
+	if last > 0 && len(closed) > last {
+		closed = closed[len(closed)-last:]
+	}
+	return closed
+}
+
+// sprintVelocity sums committed and completed estimation for one sprint.
+func sprintVelocity(sprint Sprint, issues []Issue) SprintVelocity {
+	var completed []Issue
+	for _, iss := range issues {
+		if iss.Resolved != 0 && (sprint.Finish == 0 || iss.Resolved <= sprint.Finish) {
+			completed = append(completed, iss)
+		}
+	}
+	return SprintVelocity{
+		Sprint:          sprint,
+		Committed:       SumEstimation(issues),
+		Completed:       SumEstimation(completed),
+		TotalIssues:     len(issues),
+		CompletedIssues: len(completed),
+	}
+}
+
+// SummarizeVelocity computes the average, trend and suggested capacity.
+// The suggestion is the average of the most recent sprints ("yesterday's weather").
+func SummarizeVelocity(velocities []SprintVelocity) VelocitySummary {
+	var summary VelocitySummary
+	n := len(velocities)
+	if n == 0 {
+		return summary
+	}
+
+	var total time.Duration
+	for _, v := range velocities {
+		total += v.Completed
+	}
+	summary.Average = total / time.Duration(n)
+
+	// Least squares slope over the sprint index
+	if n > 1 {
+		var sumX, sumY, sumXY, sumXX float64
+		for i, v := range velocities {
+			x, y := float64(i), float64(v.Completed)
+			sumX += x
+			sumY += y
+			sumXY += x * y
+			sumXX += x * x
+		}
+		fn := float64(n)
+		summary.Trend = time.Duration((fn*sumXY - sumX*sumY) / (fn*sumXX - sumX*sumX))
+	}
+
+	window := velocities
+	if len(window) > suggestionWindow {
+		window = window[len(window)-suggestionWindow:]
+	}
+	var recent time.Duration
+	for _, v := range window {
+		recent += v.Completed
+	}
+	summary.Suggested = recent / time.Duration(len(window))
+
+	return summary
+}
+
+// PrintVelocity prints the velocity table and its summary.
+func PrintVelocity(boardName string, velocities []SprintVelocity, summary VelocitySummary) {
+	fmt.Printf("Velocity for board '%s' (last %d closed sprint(s)):\n", boardName, len(velocities))
+	fmt.Printf("%-25s\t%-10s\t%-12s\t%-12s\t%s\n", "Sprint", "Finished", "Committed", "Completed", "Issues Done")
+	for _, v := range velocities {
+		fmt.Printf("%-25s\t%-10s\t%-12s\t%-12s\t%d/%d\n", v.Sprint.Name, formatSprintDate(v.Sprint.Finish),
+			HumanizeDuration(v.Committed), HumanizeDuration(v.Completed), v.CompletedIssues, v.TotalIssues)
+	}
+
+	trend := "flat"
+	if summary.Trend > 0 {
+		trend = fmt.Sprintf("rising, +%s per sprint", HumanizeDuration(summary.Trend))
+	} else if summary.Trend < 0 {
+		trend = fmt.Sprintf("falling, -%s per sprint", HumanizeDuration(-summary.Trend))
+	}
+
+	fmt.Printf("\nAverage completed: %s\n", HumanizeDuration(summary.Average))
+	fmt.Printf("Trend:             %s\n", trend)
+	fmt.Printf("Suggested capacity for next sprint: %s (average of the last %d sprint(s))\n",
+		HumanizeDuration(summary.Suggested), min(len(velocities), suggestionWindow))
+}
@@ -0,0 +1,219 @@
+package youtrack
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+	"youtrack-cli/internal/config"
+)
+
+// workItemFields is the field selection used when listing work items.
+const workItemFields = "id,date,duration(minutes,presentation),author(login,fullName),text,type(id,name),attributes(id,name,value(id,name)),issue(idReadable,summary,customFields(name,value(name)))"
+
+// workItemPageSize is the number of work items requested per page.
+const workItemPageSize = 100
+
+// WorkItemFilter narrows down the work items returned by FetchWorkItems.
+type WorkItemFilter struct {
+	Query  string    // Issue query, e.g. "issue id: DP-1" or a sprint query
+	Since  time.Time // Inclusive, zero for no lower bound
+	Until  time.Time // Inclusive, zero for no upper bound
+	Author string    // Login of the author, "" for everyone
+}
+
+// CurrentUser fetches the user the API token belongs to.
+func CurrentUser(cfg config.Config) (User, error) {
+	client := NewClient(cfg)
+	var user User
+	if err := client.get("/api/users/me?fields=id,login,fullName", &user); err != nil {
+		return User{}, err
+	}
+	return user, nil
+}
+
+// FetchWorkItems fetches work items across issues, oldest first.
+func FetchWorkItems(cfg config.Config, filter WorkItemFilter) ([]WorkItem, error) {
+	client := NewClient(cfg)
+
+	params := url.Values{}
+	params.Set("fields", workItemFields)
+	if filter.Query != "" {
+		params.Set("query", filter.Query)
+	}
+	if !filter.Since.IsZero() {
+		params.Set("startDate", filter.Since.Format("2006-01-02"))
+	}
+	if !filter.Until.IsZero() {
+		params.Set("endDate", filter.Until.Format("2006-01-02"))
+	}
+	if filter.Author != "" {
+		params.Set("author", filter.Author)
+	}
+	params.Set("$top", fmt.Sprint(workItemPageSize))
+
+	var items []WorkItem
+	for skip := 0; ; skip += workItemPageSize {
+		params.Set("$skip", fmt.Sprint(skip))
+		var page []WorkItem
+		if err := client.get("/api/workItems?"+params.Encode(), &page); err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		if len(page) < workItemPageSize {
+			break
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Date < items[j].Date })
+	return items, nil
+}
+
+// DayLog is the time logged on a single workday compared with the daily target.
+type DayLog struct {
+	Day    time.Time
+	Logged time.Duration
+	Target time.Duration
+}
+
+// UnderTarget reports whether less than the target was logged.
+func (d DayLog) UnderTarget() bool {
+	return d.Logged < d.Target
+}
+
+// CheckWork compares the time the current user logged on each of the last days
+// workdays (in local time) against the daily target. Today is only counted when
+// includeToday is set, since it is usually not finished yet. Days that are not
+// workdays of the calendar and days off configured under capacity are skipped. Days are returned oldest first.
+func CheckWork(cfg config.Config, days int, includeToday bool, now time.Time) ([]DayLog, error) {
+	if days < 1 {
+		return nil, fmt.Errorf("invalid number of days: %d", days)
+	}
+
+	user, err := CurrentUser(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch current user: %w", err)
+	}
+
+	last := startOfDay(now)
+	if !includeToday {
+		last = last.AddDate(0, 0, -1)
+	}
+	var logs []DayLog
+	for day := last; len(logs) < days; day = day.AddDate(0, 0, -1) {
+		if !cfg.IsWorkday(day) || cfg.Capacity.IsDayOff(user.FullName, day) {
+			continue
+		}
+		logs = append([]DayLog{{Day: day, Target: cfg.DailyTarget()}}, logs...)
+	}
+
+	items, err := FetchWorkItems(cfg, WorkItemFilter{Since: logs[0].Day, Until: logs[len(logs)-1].Day, Author: user.Login})
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int)
+	for i, log := range logs {
+		index[log.Day.Format("2006-01-02")] = i
+	}
+	for _, item := range items {
+		if i, ok := index[workItemDay(item).Format("2006-01-02")]; ok {
+			logs[i].Logged += WorkItemDuration(item)
+		}
+	}
+	return logs, nil
+}
+
+// workItemDay returns the local day a work item was logged for.
+func workItemDay(item WorkItem) time.Time {
+	return startOfDay(unixMilliToTime(item.Date))
+}
+
+// LoggedOn returns the time logged in items on an issue on the local day of day.
+func LoggedOn(items []WorkItem, issueID string, day time.Time) time.Duration {
+	var logged time.Duration
+	for _, item := range items {
+		if strings.EqualFold(workItemIssueID(item), issueID) && workItemDay(item).Equal(startOfDay(day)) {
+			logged += WorkItemDuration(item)
+		}
+	}
+	return logged
+}
+
+// workItemIssueID returns the readable ID of the issue a work item belongs to.
+func workItemIssueID(item WorkItem) string {
+	if item.Issue != nil {
+		return item.Issue.ID
+	}
+	return "N/A"
+}
+
+// workItemTypeName returns the work item type, or "N/A" when untyped.
+func workItemTypeName(item WorkItem) string {
+	if item.Type != nil && item.Type.Name != "" {
+		return item.Type.Name
+	}
+	return "N/A"
+}
+
+// WorkItemDuration converts the duration of a work item to a time.Duration.
+func WorkItemDuration(item WorkItem) time.Duration {
+	return time.Duration(item.Duration.Minutes) * time.Minute
+}
+
+// PrintWorkItems prints work items followed by totals per day and per issue.
+func PrintWorkItems(items []WorkItem) {
+	if len(items) == 0 {
+		fmt.Println("No work items found.")
+		return
+	}
+
+	fmt.Printf("%-10s\t%-10s\t%-12s\t%-8s\t%-12s\t%-15s\t%-20s\t%s\n", "Item ID", "Date", "Issue", "Duration", "Type", "Author", "Attributes", "Text")
+	var total time.Duration
+	byDay := make(map[string]time.Duration)
+	byIssue := make(map[string]time.Duration)
+	summaries := make(map[string]string)
+	for _, item := range items {
+		day := workItemDay(item).Format("2006-01-02")
+		issueID := workItemIssueID(item)
+		d := WorkItemDuration(item)
+
+		fmt.Printf("%-10s\t%-10s\t%-12s\t%-8s\t%-12s\t%-15s\t%-20s\t%s\n", item.ID, day, issueID, HumanizeDuration(d),
+			workItemTypeName(item), item.Author.Login, orNA(formatWorkItemAttributes(item.Attributes)), item.Text)
+
+		total += d
+		byDay[day] += d
+		byIssue[issueID] += d
+		if item.Issue != nil {
+			summaries[issueID] = item.Issue.Summary
+		}
+	}
+
+	days := make([]string, 0, len(byDay))
+	for day := range byDay {
+		days = append(days, day)
+	}
+	sort.Strings(days)
+	fmt.Println("\nTotal by day:")
+	for _, day := range days {
+		fmt.Printf("  %-10s\t%s\n", day, HumanizeDuration(byDay[day]))
+	}
+
+	issues := make([]string, 0, len(byIssue))
+	for id := range byIssue {
+		issues = append(issues, id)
+	}
+	sort.Slice(issues, func(i, j int) bool {
+		if byIssue[issues[i]] != byIssue[issues[j]] {
+			return byIssue[issues[i]] > byIssue[issues[j]]
+		}
+		return issues[i] < issues[j]
+	})
+	fmt.Println("\nTotal by issue:")
+	for _, id := range issues {
+		fmt.Printf("  %-12s\t%-8s\t%s\n", id, HumanizeDuration(byIssue[id]), summaries[id])
+	}
+
+	fmt.Printf("\nTotal: %s\n", HumanizeDuration(total))
+}
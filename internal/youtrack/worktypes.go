@@ -0,0 +1,206 @@
+package youtrack
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"youtrack-cli/internal/config"
+)
+
+// projectTimeTrackingFields is the field selection for project time tracking settings.
+const projectTimeTrackingFields = "enabled,workItemTypes(id,name),attributes(id,name,values(id,name))"
+
+// ListProjects fetches all projects visible to the user.
+func ListProjects(cfg config.Config) ([]Project, error) {
+	client := NewClient(cfg)
+	var projects []Project
+	if err := client.get("/api/admin/projects?fields=id,shortName,name", &projects); err != nil {
+		return nil, err
+	}
+	sort.Slice(projects, func(i, j int) bool { return projects[i].ShortName < projects[j].ShortName })
+	return projects, nil
+}
+
+// FindProject looks up a project by short name or name, ignoring case.
+func FindProject(projects []Project, name string) (Project, error) {
+	var names []string
+	for _, p := range projects {
+		if strings.EqualFold(p.ShortName, name) || strings.EqualFold(p.Name, name) {
+			return p, nil
+		}
+		names = append(names, p.ShortName)
+	}
+	return Project{}, fmt.Errorf("unknown project '%s' (available: %s)", name, strings.Join(names, ", "))
+}
+
+// FetchProjectTimeTracking fetches the time tracking settings of a project by its ID.
+func FetchProjectTimeTracking(cfg config.Config, projectID string) (ProjectTimeTracking, error) {
+	client := NewClient(cfg)
+	path := fmt.Sprintf("/api/admin/projects/%s/timeTrackingSettings?fields=%s", projectID, projectTimeTrackingFields)
+
+	var settings ProjectTimeTracking
+	if err := client.get(path, &settings); err != nil {
+		return ProjectTimeTracking{}, err
+	}
+	return settings, nil
+}
+
+// IssueTimeTracking fetches the time tracking settings of the project an issue belongs to.
+// It fails when time tracking is disabled in that project.
+func IssueTimeTracking(cfg config.Config, issueID string) (Project, ProjectTimeTracking, error) {
+	client := NewClient(cfg)
+	var issue struct {
+		Project Project `json:"project"`
+	}
+	if err := client.get(fmt.Sprintf("/api/issues/%s?fields=project(id,shortName,name)", issueID), &issue); err != nil {
+		return Project{}, ProjectTimeTracking{}, fmt.Errorf("failed to fetch issue %s: %w", issueID, err)
+	}
+
+	settings, err := FetchProjectTimeTracking(cfg, issue.Project.ID)
+	if err != nil {
+		return issue.Project, ProjectTimeTracking{}, fmt.Errorf("failed to fetch time tracking settings of %s: %w", issue.Project.ShortName, err)
+	}
+	if !settings.Enabled {
+		return issue.Project, settings, fmt.Errorf("time tracking is disabled in project %s", issue.Project.ShortName)
+	}
+	return issue.Project, settings, nil
+}
+
+// FindWorkItemType looks up a work item type by name, ignoring case.
+func FindWorkItemType(types []WorkItemType, name string) (WorkItemType, error) {
+	var names []string
+	for _, t := range types {
+		if strings.EqualFold(t.Name, name) {
+			return t, nil
+		}
+		names = append(names, t.Name)
+	}
+	if len(names) == 0 {
+		return WorkItemType{}, fmt.Errorf("unknown work item type '%s' (the project has no work item types)", name)
+	}
+	return WorkItemType{}, fmt.Errorf("unknown work item type '%s' (available: %s)", name, strings.Join(names, ", "))
+}
+
+// ResolveWorkItemAttributes turns "key=value" pairs into work item attributes, checking
+// names and values against the attributes defined in the project, ignoring case.
+func ResolveWorkItemAttributes(defs []WorkItemAttributeDef, pairs []string) ([]WorkItemAttribute, error) {
+	var attrs []WorkItemAttribute
+	seen := make(map[string]bool)
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !ok || key == "" || value == "" {
+			return nil, fmt.Errorf("invalid attribute '%s' (use key=value)", pair)
+		}
+
+		def, err := findAttributeDef(defs, key)
+		if err != nil {
+			return nil, err
+		}
+		if seen[def.ID] {
+			return nil, fmt.Errorf("attribute '%s' is given more than once", def.Name)
+		}
+		seen[def.ID] = true
+
+		var names []string
+		var match *WorkItemAttributeValue
+		for i, v := range def.Values {
+			if strings.EqualFold(v.Name, value) {
+				match = &def.Values[i]
+				break
+			}
+			names = append(names, v.Name)
+		}
+		if match == nil {
+			return nil, fmt.Errorf("invalid value '%s' for attribute '%s' (available: %s)", value, def.Name, strings.Join(names, ", "))
+		}
+		attrs = append(attrs, WorkItemAttribute{ID: def.ID, Name: def.Name, Value: &WorkItemAttributeValue{ID: match.ID, Name: match.Name}})
+	}
+	return attrs, nil
+}
+
+// findAttributeDef looks up a work item attribute definition by name, ignoring case.
+func findAttributeDef(defs []WorkItemAttributeDef, name string) (WorkItemAttributeDef, error) {
+	var names []string
+	for _, def := range defs {
+		if strings.EqualFold(def.Name, name) {
+			return def, nil
+		}
+		names = append(names, def.Name)
+	}
+	if len(names) == 0 {
+		return WorkItemAttributeDef{}, fmt.Errorf("unknown work item attribute '%s' (the project has no work item attributes)", name)
+	}
+	return WorkItemAttributeDef{}, fmt.Errorf("unknown work item attribute '%s' (available: %s)", name, strings.Join(names, ", "))
+}
+
+// timeTrackingCache fetches the time tracking settings once per project, keyed by the
+// project prefix of issue IDs.
+type timeTrackingCache struct {
+	cfg      config.Config
+	settings map[string]ProjectTimeTracking
+	errs     map[string]error
+}
+
+// newTimeTrackingCache creates an empty cache.
+func newTimeTrackingCache(cfg config.Config) *timeTrackingCache {
+	return &timeTrackingCache{cfg: cfg, settings: make(map[string]ProjectTimeTracking), errs: make(map[string]error)}
+}
+
+// forIssue returns the time tracking settings of the project an issue belongs to.
+func (c *timeTrackingCache) forIssue(issueID string) (ProjectTimeTracking, error) {
+	prefix, _, _ := strings.Cut(strings.ToUpper(issueID), "-")
+	if err, ok := c.errs[prefix]; ok {
+		return ProjectTimeTracking{}, err
+	}
+	if settings, ok := c.settings[prefix]; ok {
+		return settings, nil
+	}
+
+	_, settings, err := IssueTimeTracking(c.cfg, issueID)
+	if err != nil {
+		c.errs[prefix] = err
+		return ProjectTimeTracking{}, err
+	}
+	c.settings[prefix] = settings
+	return settings, nil
+}
+
+// formatWorkItemAttributes renders attributes as "Name: Value" pairs.
+func formatWorkItemAttributes(attrs []WorkItemAttribute) string {
+	var parts []string
+	for _, a := range attrs {
+		if a.Value != nil {
+			parts = append(parts, a.Name+": "+a.Value.Name)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// PrintProjectTimeTracking prints the work item types and attributes available in a project.
+func PrintProjectTimeTracking(project Project, settings ProjectTimeTracking) {
+	fmt.Printf("%s (%s)\n", project.ShortName, project.Name)
+	if !settings.Enabled {
+		fmt.Println("  Time tracking is disabled.")
+		return
+	}
+
+	var types []string
+	for _, t := range settings.WorkItemTypes {
+		types = append(types, t.Name)
+	}
+	fmt.Printf("  Types:\t%s\n", orNA(strings.Join(types, ", ")))
+
+	if len(settings.Attributes) == 0 {
+		fmt.Println("  Attributes:\tN/A")
+		return
+	}
+	fmt.Println("  Attributes:")
+	for _, def := range settings.Attributes {
+		var values []string
+		for _, v := range def.Values {
+			values = append(values, v.Name)
+		}
+		fmt.Printf("    %s:\t%s\n", def.Name, strings.Join(values, ", "))
+	}
+}